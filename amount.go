@@ -0,0 +1,200 @@
+package bunq
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// currencyExponents holds the ISO 4217 exponents of currencies that do not
+// have two decimal places.
+var currencyExponents = map[string]int{
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0,
+	"KRW": 0, "PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0,
+	"XAF": 0, "XOF": 0, "XPF": 0,
+}
+
+// CurrencyExponent returns the number of decimal places of an ISO 4217
+// currency code. Currencies are assumed to have two decimal places, unless
+// known otherwise.
+func CurrencyExponent(currency string) int {
+	if exp, ok := currencyExponents[currency]; ok {
+		return exp
+	}
+
+	return 2
+}
+
+// ErrCurrencyMismatch is returned by arithmetic on Amounts with different
+// currencies.
+var ErrCurrencyMismatch = errors.New("bunq: amounts have different currencies")
+
+// Amount is an exact amount of money, stored as an integer number of minor
+// units of its currency, e.g. cents for EUR. Its JSON format is the one used
+// by the bunq API: `{"value":"12.50","currency":"EUR"}`.
+type Amount struct {
+	// Units is the amount in minor units of the currency.
+	Units    int64
+	Currency string
+}
+
+// ParseAmount parses a decimal value, such as "12.50" or "-3", into an Amount
+// in currency. The value must not have more decimal places than the currency.
+func ParseAmount(value, currency string) (Amount, error) {
+	s := value
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	exp := CurrencyExponent(currency)
+	if trimmed := strings.TrimRight(fracPart, "0"); len(trimmed) > exp {
+		return Amount{}, fmt.Errorf("bunq: amount %q has more than %v decimal places for %v", value, exp, currency)
+	}
+	if intPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return Amount{}, fmt.Errorf("bunq: invalid amount %q", value)
+	}
+	if len(fracPart) > exp {
+		fracPart = fracPart[:exp]
+	}
+	fracPart += strings.Repeat("0", exp-len(fracPart))
+
+	units, err := strconv.ParseInt(intPart+fracPart, 10, 64)
+	if err != nil {
+		return Amount{}, fmt.Errorf("bunq: invalid amount %q: %v", value, err)
+	}
+	if neg {
+		units = -units
+	}
+
+	return Amount{Units: units, Currency: currency}, nil
+}
+
+func isDigits(s string) bool {
+	for i := range s {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Value returns the amount as a decimal string with the number of decimal
+// places of its currency, e.g. "12.50".
+func (a Amount) Value() string {
+	units := a.Units
+	sign := ""
+	if units < 0 {
+		sign = "-"
+	}
+	digits := strconv.FormatUint(uint64(units), 10)
+	if units < 0 {
+		digits = strconv.FormatUint(uint64(-units), 10)
+	}
+
+	exp := CurrencyExponent(a.Currency)
+	if exp == 0 {
+		return sign + digits
+	}
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+
+	return sign + digits[:len(digits)-exp] + "." + digits[len(digits)-exp:]
+}
+
+// String returns the amount with its currency, e.g. "12.50 EUR".
+func (a Amount) String() string {
+	return a.Value() + " " + a.Currency
+}
+
+// IsZero reports whether the amount is zero.
+func (a Amount) IsZero() bool {
+	return a.Units == 0
+}
+
+// IsNegative reports whether the amount is less than zero.
+func (a Amount) IsNegative() bool {
+	return a.Units < 0
+}
+
+// Neg returns the amount with its sign reversed.
+func (a Amount) Neg() Amount {
+	return Amount{Units: -a.Units, Currency: a.Currency}
+}
+
+// Add returns the sum of a and b, which must have the same currency.
+func (a Amount) Add(b Amount) (Amount, error) {
+	if a.Currency != b.Currency {
+		return Amount{}, ErrCurrencyMismatch
+	}
+	if (b.Units > 0 && a.Units > math.MaxInt64-b.Units) || (b.Units < 0 && a.Units < math.MinInt64-b.Units) {
+		return Amount{}, errors.New("bunq: amount overflow")
+	}
+
+	return Amount{Units: a.Units + b.Units, Currency: a.Currency}, nil
+}
+
+// Sub returns the difference of a and b, which must have the same currency.
+func (a Amount) Sub(b Amount) (Amount, error) {
+	if b.Units == math.MinInt64 {
+		return Amount{}, errors.New("bunq: amount overflow")
+	}
+
+	return a.Add(b.Neg())
+}
+
+// Cmp compares a and b, which must have the same currency. It returns -1 if a
+// is less than b, 0 if they are equal and +1 if a is greater than b.
+func (a Amount) Cmp(b Amount) (int, error) {
+	if a.Currency != b.Currency {
+		return 0, ErrCurrencyMismatch
+	}
+	switch {
+	case a.Units < b.Units:
+		return -1, nil
+	case a.Units > b.Units:
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+type amountJSON struct {
+	Value    string `json:"value"`
+	Currency string `json:"currency"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(amountJSON{a.Value(), a.Currency})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var v amountJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.Value == "" {
+		*a = Amount{Currency: v.Currency}
+		return nil
+	}
+	amount, err := ParseAmount(v.Value, v.Currency)
+	if err != nil {
+		return err
+	}
+	*a = amount
+
+	return nil
+}
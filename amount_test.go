@@ -0,0 +1,120 @@
+package bunq
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		value    string
+		currency string
+		exp      Amount
+		expErr   bool
+	}{
+		{"12.50", "EUR", Amount{Units: 1250, Currency: "EUR"}, false},
+		{"-0.05", "EUR", Amount{Units: -5, Currency: "EUR"}, false},
+		{"3", "EUR", Amount{Units: 300, Currency: "EUR"}, false},
+		{"1.200", "EUR", Amount{Units: 120, Currency: "EUR"}, false},
+		{"1000", "JPY", Amount{Units: 1000, Currency: "JPY"}, false},
+		{"1.001", "KWD", Amount{Units: 1001, Currency: "KWD"}, false},
+		{"0.001", "EUR", Amount{}, true},
+		{"1,50", "EUR", Amount{}, true},
+		{".50", "EUR", Amount{}, true},
+		{"", "EUR", Amount{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value+" "+tt.currency, func(t *testing.T) {
+			got, err := ParseAmount(tt.value, tt.currency)
+			if (err != nil) != tt.expErr {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.exp {
+				t.Errorf("Expected: `%#v`, got: `%#v`", tt.exp, got)
+			}
+		})
+	}
+}
+
+func TestAmountValue(t *testing.T) {
+	tests := []struct {
+		amount Amount
+		exp    string
+	}{
+		{Amount{Units: 1250, Currency: "EUR"}, "12.50"},
+		{Amount{Units: -5, Currency: "EUR"}, "-0.05"},
+		{Amount{Units: 0, Currency: "EUR"}, "0.00"},
+		{Amount{Units: 1000, Currency: "JPY"}, "1000"},
+		{Amount{Units: 1001, Currency: "KWD"}, "1.001"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.amount.Value(); got != tt.exp {
+			t.Errorf("Expected: `%v`, got: `%v`", tt.exp, got)
+		}
+	}
+	if got, exp := (Amount{Units: 1250, Currency: "EUR"}).String(), "12.50 EUR"; got != exp {
+		t.Errorf("Expected: `%v`, got: `%v`", exp, got)
+	}
+}
+
+func TestAmountArithmetic(t *testing.T) {
+	a := Amount{Units: 1250, Currency: "EUR"}
+	b := Amount{Units: 2000, Currency: "EUR"}
+
+	sum, err := a.Add(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := (Amount{Units: 3250, Currency: "EUR"}); sum != exp {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, sum)
+	}
+
+	diff, err := a.Sub(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := (Amount{Units: -750, Currency: "EUR"}); diff != exp {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, diff)
+	}
+
+	if cmp, err := a.Cmp(b); err != nil || cmp != -1 {
+		t.Errorf("Expected: `-1`, got: `%v` (%v)", cmp, err)
+	}
+	if cmp, err := b.Cmp(a); err != nil || cmp != 1 {
+		t.Errorf("Expected: `1`, got: `%v` (%v)", cmp, err)
+	}
+
+	usd := Amount{Units: 100, Currency: "USD"}
+	if _, err := a.Add(usd); err != ErrCurrencyMismatch {
+		t.Errorf("Expected: `%v`, got: `%v`", ErrCurrencyMismatch, err)
+	}
+	if _, err := a.Cmp(usd); err != ErrCurrencyMismatch {
+		t.Errorf("Expected: `%v`, got: `%v`", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestAmountJSON(t *testing.T) {
+	data := `{"value":"12.50","currency":"EUR"}`
+
+	var got Amount
+	if err := json.Unmarshal([]byte(data), &got); err != nil {
+		t.Fatal(err)
+	}
+	if exp := (Amount{Units: 1250, Currency: "EUR"}); got != exp {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+
+	encoded, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded) != data {
+		t.Errorf("Expected: `%v`, got: `%v`", data, string(encoded))
+	}
+
+	if err := json.Unmarshal([]byte(`{"value":"1.005","currency":"EUR"}`), &got); err == nil {
+		t.Error("Expected error for amount with too many decimal places.")
+	}
+}
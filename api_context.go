@@ -0,0 +1,153 @@
+package bunq
+
+import (
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+const apiContextTimeFormat = "2006-01-02 15:04:05.000000"
+
+// An APIContext holds the state that is created when registering a client at
+// the bunq API, so it can be saved and loaded again after a process restart
+// without registering a new installation and device server.
+type APIContext struct {
+	APIKey            string
+	PrivateKey        *rsa.PrivateKey
+	InstallationToken string
+	ServerPublicKey   *rsa.PublicKey
+	DeviceServerID    int
+	SessionToken      string
+	SessionExpiry     time.Time
+}
+
+// apiContextJSON is the JSON format of an APIContext. It follows the layout of
+// the context files of the official bunq SDKs.
+type apiContextJSON struct {
+	APIKey              string `json:"api_key"`
+	InstallationContext struct {
+		Token            string `json:"token"`
+		PrivateKeyClient string `json:"private_key_client"`
+		PublicKeyClient  string `json:"public_key_client"`
+		PublicKeyServer  string `json:"public_key_server"`
+	} `json:"installation_context"`
+	DeviceServerID int                 `json:"device_server_id"`
+	SessionContext *sessionContextJSON `json:"session_context,omitempty"`
+}
+
+type sessionContextJSON struct {
+	Token      string `json:"token"`
+	ExpiryTime string `json:"expiry_time,omitempty"`
+}
+
+// APIContext returns the current API context of the client.
+func (c *Client) APIContext() *APIContext {
+	token, expiry := c.session()
+
+	return &APIContext{
+		APIKey:            c.APIKey,
+		PrivateKey:        c.PrivateKey,
+		InstallationToken: c.InstallationToken,
+		ServerPublicKey:   c.ServerPublicKey,
+		DeviceServerID:    c.DeviceServerID,
+		SessionToken:      token,
+		SessionExpiry:     expiry,
+	}
+}
+
+// SetAPIContext sets the API key, keys, tokens and device server ID of the
+// client from an API context.
+func (c *Client) SetAPIContext(ac *APIContext) {
+	c.APIKey = ac.APIKey
+	c.PrivateKey = ac.PrivateKey
+	c.InstallationToken = ac.InstallationToken
+	c.ServerPublicKey = ac.ServerPublicKey
+	c.DeviceServerID = ac.DeviceServerID
+
+	c.mu.Lock()
+	c.Token = ac.SessionToken
+	c.SessionExpiry = ac.SessionExpiry
+	c.mu.Unlock()
+}
+
+// Save writes the API context to w as JSON.
+func (ac *APIContext) Save(w io.Writer) error {
+	if ac.PrivateKey == nil {
+		return errors.New("bunq: private key cannot be nil")
+	}
+
+	var v apiContextJSON
+	v.APIKey = ac.APIKey
+	v.InstallationContext.Token = ac.InstallationToken
+	v.InstallationContext.PrivateKeyClient = string(encodePrivateKey(ac.PrivateKey))
+	publicKey, err := encodePublicKey(&ac.PrivateKey.PublicKey)
+	if err != nil {
+		return fmt.Errorf("bunq: could not encode public key: %v", err)
+	}
+	v.InstallationContext.PublicKeyClient = string(publicKey)
+	if ac.ServerPublicKey != nil {
+		serverPublicKey, err := encodePublicKey(ac.ServerPublicKey)
+		if err != nil {
+			return fmt.Errorf("bunq: could not encode server public key: %v", err)
+		}
+		v.InstallationContext.PublicKeyServer = string(serverPublicKey)
+	}
+	v.DeviceServerID = ac.DeviceServerID
+	if ac.SessionToken != "" {
+		v.SessionContext = &sessionContextJSON{Token: ac.SessionToken}
+		if !ac.SessionExpiry.IsZero() {
+			v.SessionContext.ExpiryTime = ac.SessionExpiry.UTC().Format(apiContextTimeFormat)
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err = enc.Encode(v); err != nil {
+		return fmt.Errorf("bunq: could not encode API context into JSON: %v", err)
+	}
+
+	return nil
+}
+
+// Load reads an API context that was written by Save from r.
+func (ac *APIContext) Load(r io.Reader) error {
+	var v apiContextJSON
+	if err := json.NewDecoder(r).Decode(&v); err != nil {
+		return fmt.Errorf("bunq: could not decode API context from JSON: %v", err)
+	}
+
+	privKey, err := parsePrivateKey([]byte(v.InstallationContext.PrivateKeyClient), nil)
+	if err != nil {
+		return err
+	}
+	var serverPublicKey *rsa.PublicKey
+	if v.InstallationContext.PublicKeyServer != "" {
+		serverPublicKey, err = parsePublicKey([]byte(v.InstallationContext.PublicKeyServer))
+		if err != nil {
+			return err
+		}
+	}
+
+	loaded := APIContext{
+		APIKey:            v.APIKey,
+		PrivateKey:        privKey,
+		InstallationToken: v.InstallationContext.Token,
+		ServerPublicKey:   serverPublicKey,
+		DeviceServerID:    v.DeviceServerID,
+	}
+	if v.SessionContext != nil {
+		loaded.SessionToken = v.SessionContext.Token
+		if v.SessionContext.ExpiryTime != "" {
+			loaded.SessionExpiry, err = time.Parse(apiContextTimeFormat, v.SessionContext.ExpiryTime)
+			if err != nil {
+				return fmt.Errorf("bunq: could not parse session expiry time: %v", err)
+			}
+		}
+	}
+	*ac = loaded
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package bunq
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAPIContextSaveLoad(t *testing.T) {
+	client := newTestClient(t, "")
+	client.APIKey = "api-key"
+	client.InstallationToken = "installation-token"
+	client.ServerPublicKey = &client.PrivateKey.PublicKey
+	client.InsecureSkipVerify = false
+	client.DeviceServerID = 42
+	client.Token = "session-token"
+	client.SessionExpiry = time.Date(2017, 3, 11, 12, 40, 3, 613887000, time.UTC)
+
+	var buf bytes.Buffer
+	if err := client.APIContext().Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `"expiry_time": "2017-03-11 12:40:03.613887"`) {
+		t.Errorf("Expected session expiry time in saved API context, got: `%v`", buf.String())
+	}
+
+	var got APIContext
+	if err := got.Load(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	exp := client.APIContext()
+	if eq := reflect.DeepEqual(exp, &got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, &got)
+	}
+
+	loaded := NewClient()
+	loaded.SetAPIContext(&got)
+	if eq := reflect.DeepEqual(exp, loaded.APIContext()); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, loaded.APIContext())
+	}
+}
@@ -0,0 +1,146 @@
+package bunq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+)
+
+// AttachmentDescription describes the content of an attachment.
+type AttachmentDescription struct {
+	Description string `json:"description"`
+	ContentType string `json:"content_type"`
+}
+
+// An AttachmentPublic is an attachment that can be referenced by anyone, such
+// as the image of an Avatar.
+type AttachmentPublic struct {
+	UUID       string                `json:"uuid"`
+	CreatedAt  Time                  `json:"created"`
+	UpdatedAt  Time                  `json:"updated"`
+	Attachment AttachmentDescription `json:"attachment"`
+}
+
+type attachmentPublicResponse struct {
+	Response []struct {
+		UUID *struct {
+			UUID string `json:"uuid"`
+		} `json:"Uuid,omitempty"`
+		AttachmentPublic *AttachmentPublic `json:"AttachmentPublic,omitempty"`
+	} `json:"Response"`
+}
+
+// ErrAttachmentPublicNotFound is returned when a single AttachmentPublic
+// resource was not found.
+var ErrAttachmentPublicNotFound = errors.New("public attachment not found")
+
+// newAttachmentRequest returns a request that uploads the content of r as raw
+// bytes, which are signed as they are. The content is read into memory,
+// because the signature covers all of it.
+func newAttachmentRequest(endpoint, contentType, description string, r io.Reader) (*request, error) {
+	body, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("bunq: could not read attachment: %v", err)
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+	header.Set("X-Bunq-Attachment-Description", description)
+
+	return &request{
+		method:   http.MethodPost,
+		endpoint: endpoint,
+		header:   header,
+		body:     body,
+	}, nil
+}
+
+// download sends a GET request for the raw content at endpoint. The caller
+// must close the returned content. The content is streamed, and the response
+// signature is verified when it is read to the end.
+func (c *Client) download(ctx context.Context, endpoint string) (io.ReadCloser, error) {
+	resp, err := c.send(ctx, &request{method: http.MethodGet, endpoint: endpoint, stream: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// CreateAttachmentPublic uploads the content of r as an AttachmentPublic
+// resource at the bunq API, and returns its UUID. All content is read into
+// memory before it is sent, because the request signature covers it.
+func (c *Client) CreateAttachmentPublic(ctx context.Context, contentType, description string, r io.Reader) (string, error) {
+	req, err := newAttachmentRequest("attachment-public", contentType, description, r)
+	if err != nil {
+		return "", err
+	}
+
+	var apr attachmentPublicResponse
+	if err = c.doRequest(ctx, req, &apr); err != nil {
+		return "", err
+	}
+	for i := range apr.Response {
+		if apr.Response[i].UUID != nil {
+			return apr.Response[i].UUID.UUID, nil
+		}
+	}
+
+	return "", errors.New("bunq: api response did not contain results")
+}
+
+// GetAttachmentPublic gets an AttachmentPublic resource at the bunq API.
+func (c *Client) GetAttachmentPublic(ctx context.Context, uuid string) (*AttachmentPublic, error) {
+	var apr attachmentPublicResponse
+	if err := c.do(ctx, http.MethodGet, "attachment-public/"+uuid, nil, &apr); err != nil {
+		return nil, err
+	}
+	for i := range apr.Response {
+		if apr.Response[i].AttachmentPublic != nil {
+			return apr.Response[i].AttachmentPublic, nil
+		}
+	}
+
+	return nil, ErrAttachmentPublicNotFound
+}
+
+// GetAttachmentPublicContent downloads the content of an AttachmentPublic
+// resource at the bunq API. The caller must close the returned content. The
+// content is streamed, so reading it returns ErrInvalidServerSignature instead
+// of io.EOF if the response signature turns out to be invalid at its end.
+func (c *Client) GetAttachmentPublicContent(ctx context.Context, uuid string) (io.ReadCloser, error) {
+	return c.download(ctx, "attachment-public/"+uuid+"/content")
+}
+
+func monetaryAccountAttachmentEndpoint(userID, monetaryAccountID int) string {
+	return fmt.Sprintf("user/%v/monetary-account/%v/attachment", userID, monetaryAccountID)
+}
+
+// CreateMonetaryAccountAttachment uploads the content of r as an attachment
+// of a monetary account at the bunq API, e.g. for a Payment, and returns its
+// ID. All content is read into memory before it is sent, because the request
+// signature covers it.
+func (c *Client) CreateMonetaryAccountAttachment(ctx context.Context, userID, monetaryAccountID int, contentType, description string, r io.Reader) (int, error) {
+	req, err := newAttachmentRequest(monetaryAccountAttachmentEndpoint(userID, monetaryAccountID), contentType, description, r)
+	if err != nil {
+		return 0, err
+	}
+
+	var idResp idResponse
+	if err = c.doRequest(ctx, req, &idResp); err != nil {
+		return 0, err
+	}
+
+	return idResp.id()
+}
+
+// GetMonetaryAccountAttachmentContent downloads the content of an attachment
+// of a monetary account at the bunq API. The caller must close the returned
+// content. Like GetAttachmentPublicContent, the content is streamed.
+func (c *Client) GetMonetaryAccountAttachmentContent(ctx context.Context, userID, monetaryAccountID, id int) (io.ReadCloser, error) {
+	return c.download(ctx, monetaryAccountAttachmentEndpoint(userID, monetaryAccountID)+"/"+strconv.Itoa(id)+"/content")
+}
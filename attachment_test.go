@@ -0,0 +1,233 @@
+package bunq
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testImage = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+// verifyClientSignature verifies the signature of a request, sent by client,
+// over its raw body.
+func verifyClientSignature(t *testing.T, client *Client, r *http.Request, body []byte) {
+	var keys []string
+	for key := range r.Header {
+		if strings.HasPrefix(key, "X-Bunq-") && key != "X-Bunq-Client-Signature" || key == "User-Agent" || key == "Cache-Control" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	message := r.Method + " " + r.URL.Path + "\n"
+	for _, key := range keys {
+		message += key + ": " + r.Header.Get(key) + "\n"
+	}
+	message += "\n" + string(body)
+
+	signature, err := base64.StdEncoding.DecodeString(r.Header.Get("X-Bunq-Client-Signature"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hashed := sha256.Sum256([]byte(message))
+	if err = rsa.VerifyPKCS1v15(&client.PrivateKey.PublicKey, crypto.SHA256, hashed[:], signature); err != nil {
+		t.Errorf("Invalid client signature: %v", err)
+	}
+}
+
+func TestCreateAttachmentPublic(t *testing.T) {
+	var client *Client
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "POST /v1/attachment-public"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		if exp, got := "image/png", r.Header.Get("Content-Type"); got != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, got)
+		}
+		if exp, got := "Logo", r.Header.Get("X-Bunq-Attachment-Description"); got != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, got)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if !bytes.Equal(body, testImage) {
+			t.Errorf("Expected: `%q`, got: `%q`", testImage, body)
+		}
+		verifyClientSignature(t, client, r, body)
+		fmt.Fprintln(w, `{"Response":[{"Uuid":{"uuid":"9b1e-3b2a"}}]}`)
+	}))
+	defer ts.Close()
+
+	client = newTestClient(t, ts.URL)
+
+	got, err := client.CreateAttachmentPublic(context.Background(), "image/png", "Logo", bytes.NewReader(testImage))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "9b1e-3b2a" {
+		t.Errorf("Expected: `%v`, got: `%v`", "9b1e-3b2a", got)
+	}
+}
+
+func TestGetAttachmentPublic(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "GET /v1/attachment-public/9b1e-3b2a"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		fmt.Fprintln(w, `{"Response":[{"AttachmentPublic":{"uuid":"9b1e-3b2a","created":"2017-03-01 10:00:00.000000","updated":"2017-03-01 10:00:00.000000","attachment":{"description":"Logo","content_type":"image/png"}}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.GetAttachmentPublic(context.Background(), "9b1e-3b2a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := &AttachmentPublic{
+		UUID:       "9b1e-3b2a",
+		CreatedAt:  Time(time.Date(2017, 3, 1, 10, 0, 0, 0, time.UTC)),
+		UpdatedAt:  Time(time.Date(2017, 3, 1, 10, 0, 0, 0, time.UTC)),
+		Attachment: AttachmentDescription{Description: "Logo", ContentType: "image/png"},
+	}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+}
+
+func TestGetAttachmentPublicContent(t *testing.T) {
+	var client *Client
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "GET /v1/attachment-public/9b1e-3b2a/content"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		writeSignedResponse(t, w, client, string(testImage), string(testImage))
+	}))
+	defer ts.Close()
+
+	client = newTestClient(t, ts.URL)
+	client.ServerPublicKey = &client.PrivateKey.PublicKey
+	client.InsecureSkipVerify = false
+
+	content, err := client.GetAttachmentPublicContent(context.Background(), "9b1e-3b2a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer content.Close()
+
+	got, err := ioutil.ReadAll(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, testImage) {
+		t.Errorf("Expected: `%q`, got: `%q`", testImage, got)
+	}
+}
+
+func TestGetAttachmentPublicContentStream(t *testing.T) {
+	content := bytes.Repeat(testImage, 256*1024)
+	half := len(content) / 2
+	read := make(chan struct{})
+
+	var client *Client
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeSignedResponse(t, w, client, string(content), string(content[:half]))
+		w.(http.Flusher).Flush()
+		select {
+		case <-read:
+		case <-time.After(5 * time.Second):
+			t.Error("Expected content to be streamed before the response was complete")
+		}
+		w.Write(content[half:])
+	}))
+	defer ts.Close()
+
+	client = newTestClient(t, ts.URL)
+	client.ServerPublicKey = &client.PrivateKey.PublicKey
+	client.InsecureSkipVerify = false
+
+	rc, err := client.GetAttachmentPublicContent(context.Background(), "9b1e-3b2a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+
+	first := make([]byte, half)
+	if _, err = io.ReadFull(rc, first); err != nil {
+		t.Fatal(err)
+	}
+	close(read)
+	rest, err := ioutil.ReadAll(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := append(first, rest...); !bytes.Equal(got, content) {
+		t.Errorf("Expected %v bytes of content, got: %v bytes", len(content), len(got))
+	}
+}
+
+func TestGetAttachmentPublicContentInvalidSignature(t *testing.T) {
+	content := bytes.Repeat(testImage, 256*1024)
+
+	var client *Client
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tampered := append([]byte(nil), content...)
+		tampered[len(tampered)-1]++
+		writeSignedResponse(t, w, client, string(content), string(tampered))
+	}))
+	defer ts.Close()
+
+	client = newTestClient(t, ts.URL)
+	client.ServerPublicKey = &client.PrivateKey.PublicKey
+	client.InsecureSkipVerify = false
+
+	rc, err := client.GetAttachmentPublicContent(context.Background(), "9b1e-3b2a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+
+	if _, err = ioutil.ReadAll(rc); err != ErrInvalidServerSignature {
+		t.Errorf("Expected: `%v`, got: `%v`", ErrInvalidServerSignature, err)
+	}
+	if _, err = rc.Read(make([]byte, 1)); err != ErrInvalidServerSignature {
+		t.Errorf("Expected: `%v`, got: `%v`", ErrInvalidServerSignature, err)
+	}
+}
+
+func TestCreateMonetaryAccountAttachment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "POST /v1/user/42/monetary-account/7/attachment"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if !bytes.Equal(body, testImage) {
+			t.Errorf("Expected: `%q`, got: `%q`", testImage, body)
+		}
+		fmt.Fprintln(w, `{"Response":[{"Id":{"id":13}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.CreateMonetaryAccountAttachment(context.Background(), 42, 7, "image/png", "Receipt", bytes.NewReader(testImage))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 13 {
+		t.Errorf("Expected: `%v`, got: `%v`", 13, got)
+	}
+}
@@ -0,0 +1,93 @@
+package bunq
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+)
+
+type avatarResponse struct {
+	Response []struct {
+		UUID *struct {
+			UUID string `json:"uuid"`
+		} `json:"Uuid,omitempty"`
+		Avatar *Avatar `json:"Avatar,omitempty"`
+	} `json:"Response"`
+}
+
+// ErrAvatarNotFound is returned when a single Avatar resource was not found.
+var ErrAvatarNotFound = errors.New("avatar not found")
+
+// ErrAvatarImageNotFound is returned when an Avatar has no images.
+var ErrAvatarImageNotFound = errors.New("avatar image not found")
+
+// ImageForSize returns the smallest image of the avatar that is at least
+// width by height pixels, or the largest image if none is. False is returned
+// if the avatar has no images.
+func (a *Avatar) ImageForSize(width, height int) (AvatarImage, bool) {
+	var best AvatarImage
+	found := false
+	for _, image := range a.Image {
+		fits := image.Width >= width && image.Height >= height
+		switch {
+		case !found:
+			best, found = image, true
+		case fits && (best.Width < width || best.Height < height || image.Width*image.Height < best.Width*best.Height):
+			best = image
+		case !fits && (best.Width < width || best.Height < height) && image.Width*image.Height > best.Width*best.Height:
+			best = image
+		}
+	}
+
+	return best, found
+}
+
+// CreateAvatar creates an Avatar resource from an AttachmentPublic at the bunq
+// API, and returns its UUID. The UUID can be set as avatar of a user or
+// monetary account, e.g. with MonetaryAccountParams.AvatarUUID.
+func (c *Client) CreateAvatar(ctx context.Context, attachmentPublicUUID string) (string, error) {
+	body := struct {
+		AttachmentPublicUUID string `json:"attachment_public_uuid"`
+	}{attachmentPublicUUID}
+
+	var ar avatarResponse
+	if err := c.do(ctx, http.MethodPost, "avatar", body, &ar); err != nil {
+		return "", err
+	}
+	for i := range ar.Response {
+		if ar.Response[i].UUID != nil {
+			return ar.Response[i].UUID.UUID, nil
+		}
+	}
+
+	return "", errors.New("bunq: api response did not contain results")
+}
+
+// GetAvatar gets an Avatar resource at the bunq API.
+func (c *Client) GetAvatar(ctx context.Context, uuid string) (*Avatar, error) {
+	var ar avatarResponse
+	if err := c.do(ctx, http.MethodGet, "avatar/"+uuid, nil, &ar); err != nil {
+		return nil, err
+	}
+	for i := range ar.Response {
+		if ar.Response[i].Avatar != nil {
+			return ar.Response[i].Avatar, nil
+		}
+	}
+
+	return nil, ErrAvatarNotFound
+}
+
+// GetAvatarImageContent downloads the image of an avatar that best fits width
+// by height pixels, as chosen by Avatar.ImageForSize. The caller must close
+// the returned content. Like GetAttachmentPublicContent, the content is
+// streamed.
+func (c *Client) GetAvatarImageContent(ctx context.Context, avatar *Avatar, width, height int) (io.ReadCloser, error) {
+	image, ok := avatar.ImageForSize(width, height)
+	if !ok {
+		return nil, ErrAvatarImageNotFound
+	}
+
+	return c.GetAttachmentPublicContent(ctx, image.AttachmentPublicUUID)
+}
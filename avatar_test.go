@@ -0,0 +1,126 @@
+package bunq
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestCreateAvatar(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "POST /v1/avatar"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if exp := `{"attachment_public_uuid":"9b1e-3b2a"}`; string(body) != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, string(body))
+		}
+		fmt.Fprintln(w, `{"Response":[{"Uuid":{"uuid":"a7c4-51e0"}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.CreateAvatar(context.Background(), "9b1e-3b2a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "a7c4-51e0" {
+		t.Errorf("Expected: `%v`, got: `%v`", "a7c4-51e0", got)
+	}
+}
+
+func TestGetAvatar(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "GET /v1/avatar/a7c4-51e0"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		fmt.Fprintln(w, `{"Response":[{"Avatar":{"uuid":"a7c4-51e0","anchor_uuid":"","image":[{"attachment_public_uuid":"9b1e-3b2a","content_type":"image/png","height":1024,"width":1024}]}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.GetAvatar(context.Background(), "a7c4-51e0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := &Avatar{
+		UUID:  "a7c4-51e0",
+		Image: []AvatarImage{{AttachmentPublicUUID: "9b1e-3b2a", ContentType: "image/png", Height: 1024, Width: 1024}},
+	}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+}
+
+func TestAvatarImageForSize(t *testing.T) {
+	avatar := &Avatar{
+		Image: []AvatarImage{
+			{AttachmentPublicUUID: "large", Height: 1024, Width: 1024},
+			{AttachmentPublicUUID: "small", Height: 64, Width: 64},
+			{AttachmentPublicUUID: "medium", Height: 256, Width: 256},
+		},
+	}
+
+	tests := []struct {
+		width, height int
+		exp           string
+	}{
+		{32, 32, "small"},
+		{64, 64, "small"},
+		{100, 100, "medium"},
+		{512, 512, "large"},
+		{2048, 2048, "large"},
+	}
+
+	for _, tt := range tests {
+		image, ok := avatar.ImageForSize(tt.width, tt.height)
+		if !ok || image.AttachmentPublicUUID != tt.exp {
+			t.Errorf("Expected: `%v`, got: `%v`", tt.exp, image.AttachmentPublicUUID)
+		}
+	}
+
+	if _, ok := (&Avatar{}).ImageForSize(64, 64); ok {
+		t.Error("Expected no image for avatar without images.")
+	}
+}
+
+func TestGetAvatarImageContent(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "GET /v1/attachment-public/small/content"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		w.Write(testImage)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+	avatar := &Avatar{
+		Image: []AvatarImage{
+			{AttachmentPublicUUID: "large", Height: 1024, Width: 1024},
+			{AttachmentPublicUUID: "small", Height: 64, Width: 64},
+		},
+	}
+
+	content, err := client.GetAvatarImageContent(context.Background(), avatar, 48, 48)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer content.Close()
+
+	got, err := ioutil.ReadAll(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, testImage) {
+		t.Errorf("Expected: `%q`, got: `%q`", testImage, got)
+	}
+}
@@ -0,0 +1,46 @@
+package bunq
+
+import (
+	"context"
+	"net"
+)
+
+// Bootstrap performs the handshake needed to use the bunq API with the API key
+// and private key of the client: it creates an Installation, registers a
+// DeviceServer and starts a Session. The installation token, server public
+// key, device server ID and session token are stored on the client, after
+// which it is ready to call any other endpoint.
+//
+// Steps that were completed before, as indicated by the InstallationToken,
+// ServerPublicKey and DeviceServerID fields of the client, are skipped. This
+// allows Bootstrap to be called again to start a new session for an existing
+// installation.
+func (c *Client) Bootstrap(ctx context.Context, description string, permittedIPs []net.IP) (*Session, error) {
+	if c.InstallationToken == "" {
+		installation, err := c.CreateInstallation(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.InstallationToken = installation.Token.Token
+	}
+
+	if c.ServerPublicKey == nil {
+		installationID, err := c.GetInstallationID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = c.GetServerPublicKey(ctx, installationID); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.DeviceServerID == 0 {
+		deviceServer, err := c.CreateDeviceServer(ctx, description, permittedIPs)
+		if err != nil {
+			return nil, err
+		}
+		c.DeviceServerID = deviceServer.ID
+	}
+
+	return c.startSession(ctx)
+}
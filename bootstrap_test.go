@@ -0,0 +1,108 @@
+package bunq
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func newBootstrapServer(t *testing.T, client **Client, calls *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls = append(*calls, r.Method+" "+r.URL.Path+" "+r.Header.Get("X-Bunq-Client-Authentication"))
+
+		publicKey, err := (*client).publicKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		publicKeyJSON, err := json.Marshal(string(publicKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var body string
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v1/installation":
+			body = `{"Response":[{"Id":{"id":4971}},{"Token":{"id":11352,"created":"2017-03-11 12:40:03.613887","updated":"2017-03-11 12:40:03.613887","token":"installation-token"}},{"ServerPublicKey":{"server_public_key":` + string(publicKeyJSON) + `}}]}`
+		case "GET /v1/installation":
+			body = `{"Response":[{"Id":{"id":4971}}]}`
+		case "GET /v1/installation/4971/server-public-key":
+			body = `{"Response":[{"ServerPublicKey":{"server_public_key":` + string(publicKeyJSON) + `}}]}`
+		case "POST /v1/device-server":
+			body = `{"Response":[{"Id":{"id":42}}]}`
+		case "POST /v1/session-server":
+			body = `{"Response":[{"Id":{"id":72}},{"Token":{"id":839,"token":"session-token"}}]}`
+		default:
+			t.Fatalf("Unexpected request: %v %v", r.Method, r.URL.Path)
+		}
+		writeSignedResponse(t, w, *client, body, body)
+	}))
+}
+
+func TestBootstrap(t *testing.T) {
+	var client *Client
+	var calls []string
+	ts := newBootstrapServer(t, &client, &calls)
+	defer ts.Close()
+
+	client = newTestClient(t, ts.URL)
+	client.APIKey = "api-key"
+	client.InsecureSkipVerify = false
+
+	if _, err := client.Bootstrap(context.Background(), "Foobar", []net.IP{}); err != nil {
+		t.Fatal(err)
+	}
+
+	expCalls := []string{
+		"POST /v1/installation ",
+		"POST /v1/device-server installation-token",
+		"POST /v1/session-server installation-token",
+	}
+	if eq := reflect.DeepEqual(expCalls, calls); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", expCalls, calls)
+	}
+	if client.ServerPublicKey == nil {
+		t.Error("Expected server public key to be set.")
+	}
+	if exp := "installation-token"; client.InstallationToken != exp {
+		t.Errorf("Expected: `%v`, got: `%v`", exp, client.InstallationToken)
+	}
+	if exp := 42; client.DeviceServerID != exp {
+		t.Errorf("Expected: `%v`, got: `%v`", exp, client.DeviceServerID)
+	}
+	if exp := "session-token"; client.Token != exp {
+		t.Errorf("Expected: `%v`, got: `%v`", exp, client.Token)
+	}
+}
+
+func TestBootstrapExistingInstallation(t *testing.T) {
+	var client *Client
+	var calls []string
+	ts := newBootstrapServer(t, &client, &calls)
+	defer ts.Close()
+
+	client = newTestClient(t, ts.URL)
+	client.APIKey = "api-key"
+	client.InsecureSkipVerify = false
+	client.InstallationToken = "installation-token"
+	client.DeviceServerID = 42
+
+	if _, err := client.Bootstrap(context.Background(), "Foobar", []net.IP{}); err != nil {
+		t.Fatal(err)
+	}
+
+	expCalls := []string{
+		"GET /v1/installation installation-token",
+		"GET /v1/installation/4971/server-public-key installation-token",
+		"POST /v1/session-server installation-token",
+	}
+	if eq := reflect.DeepEqual(expCalls, calls); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", expCalls, calls)
+	}
+	if exp := "session-token"; client.Token != exp {
+		t.Errorf("Expected: `%v`, got: `%v`", exp, client.Token)
+	}
+}
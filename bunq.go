@@ -3,6 +3,8 @@ package bunq
 import (
 	"crypto/rsa"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/satori/go.uuid"
 )
@@ -21,6 +23,46 @@ type Client struct {
 	APIKey     string
 	Token      string
 	PrivateKey *rsa.PrivateKey
+
+	// ServerPublicKey is used to verify the signatures of API responses. It
+	// is set by CreateInstallation, and requests fail with
+	// ErrMissingServerPublicKey when it is nil.
+	ServerPublicKey *rsa.PublicKey
+
+	// InsecureSkipVerify disables the verification of the signatures of API
+	// responses, so that they are accepted without a server public key. It
+	// should only be used for testing.
+	InsecureSkipVerify bool
+
+	// InstallationToken authenticates the requests for creating device
+	// servers and sessions. It is set by Bootstrap.
+	InstallationToken string
+
+	// DeviceServerID is the ID of the DeviceServer registered for this client.
+	// It is set by Bootstrap.
+	DeviceServerID int
+
+	// SessionExpiry is the time at which the session of Token expires. It is
+	// set by Bootstrap, and is zero if unknown. When InstallationToken is set,
+	// the session is renewed automatically around this time.
+	SessionExpiry time.Time
+
+	// Middleware wraps the HTTP client for every request sent to the API, in
+	// order: the first middleware is the outermost.
+	Middleware []Middleware
+
+	// RateLimiter delays requests to stay within the rate limits of the API.
+	// Requests are not limited if it is nil.
+	RateLimiter *RateLimiter
+
+	// Retry configures retries of idempotent requests that failed because of
+	// rate limiting or server errors. Requests are not retried if it is nil.
+	Retry *RetryPolicy
+
+	// mu guards Token and SessionExpiry once the client is in use.
+	mu sync.Mutex
+	// renewMu serializes session renewals.
+	renewMu sync.Mutex
 }
 
 // NewClient returns a new Client.
@@ -0,0 +1,264 @@
+package bunq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// The statuses of a BunqMeTab.
+const (
+	BunqMeTabStatusWaitingForPayment = "WAITING_FOR_PAYMENT"
+	BunqMeTabStatusCancelled         = "CANCELLED"
+	BunqMeTabStatusExpired           = "EXPIRED"
+)
+
+// A BunqMeTabEntry describes the payment that is asked for by a BunqMeTab.
+type BunqMeTabEntry struct {
+	UUID              string                    `json:"uuid"`
+	AmountInquired    Amount                    `json:"amount_inquired"`
+	Alias             LabelMonetaryAccount      `json:"alias"`
+	Description       string                    `json:"description"`
+	Status            string                    `json:"status"`
+	RedirectURL       string                    `json:"redirect_url"`
+	MerchantAvailable []BunqMeMerchantAvailable `json:"merchant_available"`
+}
+
+// BunqMeMerchantAvailable tells whether a payment method, such as "IDEAL", can
+// be used to pay a BunqMeTab.
+type BunqMeMerchantAvailable struct {
+	MerchantType string `json:"merchant_type"`
+	Available    bool   `json:"available"`
+}
+
+// A BunqMeTabResultInquiry is a payment made through a BunqMeTab.
+type BunqMeTabResultInquiry struct {
+	Payment     *Payment `json:"payment"`
+	BunqMeTabID int      `json:"bunq_me_tab_id"`
+}
+
+// A BunqMeTab is a bunq.me payment link to a monetary account, that can be
+// paid by anyone who opens its share URL.
+type BunqMeTab struct {
+	ID                int                      `json:"id"`
+	CreatedAt         Time                     `json:"created"`
+	UpdatedAt         Time                     `json:"updated"`
+	TimeExpiry        *Time                    `json:"time_expiry"`
+	MonetaryAccountID int                      `json:"monetary_account_id"`
+	Status            string                   `json:"status"`
+	ShareURL          string                   `json:"bunqme_tab_share_url"`
+	Entry             BunqMeTabEntry           `json:"bunqme_tab_entry"`
+	ResultInquiries   []BunqMeTabResultInquiry `json:"result_inquiries"`
+}
+
+// BunqMeTabEntryParams are the fields of the payment that is asked for by a
+// BunqMeTab.
+type BunqMeTabEntryParams struct {
+	AmountInquired Amount `json:"amount_inquired"`
+	Description    string `json:"description"`
+	// RedirectURL is the URL the payer is sent to after paying.
+	RedirectURL string `json:"redirect_url,omitempty"`
+}
+
+// BunqMeTabParams are the fields for creating a BunqMeTab.
+type BunqMeTabParams struct {
+	Entry BunqMeTabEntryParams `json:"bunqme_tab_entry"`
+}
+
+type bunqMeTabResponse struct {
+	Response []struct {
+		BunqMeTab *BunqMeTab `json:"BunqMeTab,omitempty"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+func (bmtr *bunqMeTabResponse) bunqMeTabs() []*BunqMeTab {
+	var tabs []*BunqMeTab
+	for i := range bmtr.Response {
+		if bmtr.Response[i].BunqMeTab != nil {
+			tabs = append(tabs, bmtr.Response[i].BunqMeTab)
+		}
+	}
+
+	return tabs
+}
+
+// ErrBunqMeTabNotFound is returned when a single BunqMeTab resource was not
+// found.
+var ErrBunqMeTabNotFound = errors.New("bunq.me tab not found")
+
+func bunqMeTabEndpoint(userID, monetaryAccountID int) string {
+	return fmt.Sprintf("user/%v/monetary-account/%v/bunqme-tab", userID, monetaryAccountID)
+}
+
+// CreateBunqMeTab creates a BunqMeTab resource for a monetary account at the
+// bunq API and returns its ID. Get the tab for its share URL.
+func (c *Client) CreateBunqMeTab(ctx context.Context, userID, monetaryAccountID int, params *BunqMeTabParams) (int, error) {
+	return c.doID(ctx, http.MethodPost, bunqMeTabEndpoint(userID, monetaryAccountID), params)
+}
+
+// CancelBunqMeTab cancels a BunqMeTab resource at the bunq API, after which it
+// can no longer be paid.
+func (c *Client) CancelBunqMeTab(ctx context.Context, userID, monetaryAccountID, id int) error {
+	body := struct {
+		Status string `json:"status"`
+	}{BunqMeTabStatusCancelled}
+	_, err := c.doID(ctx, http.MethodPut, bunqMeTabEndpoint(userID, monetaryAccountID)+"/"+strconv.Itoa(id), body)
+	return err
+}
+
+// GetBunqMeTab gets a BunqMeTab resource at the bunq API.
+func (c *Client) GetBunqMeTab(ctx context.Context, userID, monetaryAccountID, id int) (*BunqMeTab, error) {
+	var bmtr bunqMeTabResponse
+	endpoint := bunqMeTabEndpoint(userID, monetaryAccountID) + "/" + strconv.Itoa(id)
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &bmtr); err != nil {
+		return nil, err
+	}
+
+	tabs := bmtr.bunqMeTabs()
+	if len(tabs) == 0 {
+		return nil, ErrBunqMeTabNotFound
+	}
+
+	return tabs[0], nil
+}
+
+// ListBunqMeTabs gets a page of BunqMeTab resources of a monetary account at
+// the bunq API.
+func (c *Client) ListBunqMeTabs(ctx context.Context, userID, monetaryAccountID int, opts *ListOptions) ([]*BunqMeTab, error) {
+	tabs, _, err := c.listBunqMeTabs(ctx, opts.endpoint(bunqMeTabEndpoint(userID, monetaryAccountID)))
+	return tabs, err
+}
+
+// IterateBunqMeTabs returns an iterator over all BunqMeTab resources of a
+// monetary account at the bunq API.
+func (c *Client) IterateBunqMeTabs(userID, monetaryAccountID int, opts *ListOptions) *Iterator[*BunqMeTab] {
+	return newIterator(opts.endpoint(bunqMeTabEndpoint(userID, monetaryAccountID)), c.listBunqMeTabs)
+}
+
+func (c *Client) listBunqMeTabs(ctx context.Context, endpoint string) ([]*BunqMeTab, Pagination, error) {
+	var bmtr bunqMeTabResponse
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &bmtr); err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return bmtr.bunqMeTabs(), bmtr.Pagination, nil
+}
+
+// A BunqMeFundraiserProfile is the bunq.me page of a monetary account, where
+// anyone can pay an amount of their choice.
+type BunqMeFundraiserProfile struct {
+	ID          int                  `json:"id"`
+	Color       string               `json:"color"`
+	Alias       LabelMonetaryAccount `json:"alias"`
+	Description string               `json:"description"`
+	Attachment  []PaymentAttachment  `json:"attachment"`
+	Pointer     *Alias               `json:"pointer"`
+	Status      string               `json:"status"`
+	RedirectURL string               `json:"redirect_url"`
+}
+
+type bunqMeFundraiserProfileResponse struct {
+	Response []struct {
+		BunqMeFundraiserProfile *BunqMeFundraiserProfile `json:"BunqMeFundraiserProfile,omitempty"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+func (bmfpr *bunqMeFundraiserProfileResponse) bunqMeFundraiserProfiles() []*BunqMeFundraiserProfile {
+	var profiles []*BunqMeFundraiserProfile
+	for i := range bmfpr.Response {
+		if bmfpr.Response[i].BunqMeFundraiserProfile != nil {
+			profiles = append(profiles, bmfpr.Response[i].BunqMeFundraiserProfile)
+		}
+	}
+
+	return profiles
+}
+
+// ErrBunqMeFundraiserProfileNotFound is returned when a single
+// BunqMeFundraiserProfile resource was not found.
+var ErrBunqMeFundraiserProfileNotFound = errors.New("bunq.me fundraiser profile not found")
+
+func bunqMeFundraiserProfileEndpoint(userID, monetaryAccountID int) string {
+	return fmt.Sprintf("user/%v/monetary-account/%v/bunqme-fundraiser-profile", userID, monetaryAccountID)
+}
+
+// GetBunqMeFundraiserProfile gets a BunqMeFundraiserProfile resource at the
+// bunq API.
+func (c *Client) GetBunqMeFundraiserProfile(ctx context.Context, userID, monetaryAccountID, id int) (*BunqMeFundraiserProfile, error) {
+	var bmfpr bunqMeFundraiserProfileResponse
+	endpoint := bunqMeFundraiserProfileEndpoint(userID, monetaryAccountID) + "/" + strconv.Itoa(id)
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &bmfpr); err != nil {
+		return nil, err
+	}
+
+	profiles := bmfpr.bunqMeFundraiserProfiles()
+	if len(profiles) == 0 {
+		return nil, ErrBunqMeFundraiserProfileNotFound
+	}
+
+	return profiles[0], nil
+}
+
+// ListBunqMeFundraiserProfiles gets a page of BunqMeFundraiserProfile
+// resources of a monetary account at the bunq API.
+func (c *Client) ListBunqMeFundraiserProfiles(ctx context.Context, userID, monetaryAccountID int, opts *ListOptions) ([]*BunqMeFundraiserProfile, error) {
+	profiles, _, err := c.listBunqMeFundraiserProfiles(ctx, opts.endpoint(bunqMeFundraiserProfileEndpoint(userID, monetaryAccountID)))
+	return profiles, err
+}
+
+// IterateBunqMeFundraiserProfiles returns an iterator over all
+// BunqMeFundraiserProfile resources of a monetary account at the bunq API.
+func (c *Client) IterateBunqMeFundraiserProfiles(userID, monetaryAccountID int, opts *ListOptions) *Iterator[*BunqMeFundraiserProfile] {
+	return newIterator(opts.endpoint(bunqMeFundraiserProfileEndpoint(userID, monetaryAccountID)), c.listBunqMeFundraiserProfiles)
+}
+
+func (c *Client) listBunqMeFundraiserProfiles(ctx context.Context, endpoint string) ([]*BunqMeFundraiserProfile, Pagination, error) {
+	var bmfpr bunqMeFundraiserProfileResponse
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &bmfpr); err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return bmfpr.bunqMeFundraiserProfiles(), bmfpr.Pagination, nil
+}
+
+// A BunqMeFundraiserResult holds the payments made through a
+// BunqMeFundraiserProfile.
+type BunqMeFundraiserResult struct {
+	ID                      int                     `json:"id"`
+	CreatedAt               Time                    `json:"created"`
+	UpdatedAt               Time                    `json:"updated"`
+	BunqMeFundraiserProfile BunqMeFundraiserProfile `json:"bunqme_fundraiser_profile"`
+	Payments                []*Payment              `json:"payments"`
+}
+
+type bunqMeFundraiserResultResponse struct {
+	Response []struct {
+		BunqMeFundraiserResult *BunqMeFundraiserResult `json:"BunqMeFundraiserResult,omitempty"`
+	} `json:"Response"`
+}
+
+// ErrBunqMeFundraiserResultNotFound is returned when a single
+// BunqMeFundraiserResult resource was not found.
+var ErrBunqMeFundraiserResultNotFound = errors.New("bunq.me fundraiser result not found")
+
+// GetBunqMeFundraiserResult gets a BunqMeFundraiserResult resource at the bunq
+// API.
+func (c *Client) GetBunqMeFundraiserResult(ctx context.Context, userID, monetaryAccountID, id int) (*BunqMeFundraiserResult, error) {
+	var bmfrr bunqMeFundraiserResultResponse
+	endpoint := fmt.Sprintf("user/%v/monetary-account/%v/bunqme-fundraiser-result/%v", userID, monetaryAccountID, id)
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &bmfrr); err != nil {
+		return nil, err
+	}
+
+	for i := range bmfrr.Response {
+		if bmfrr.Response[i].BunqMeFundraiserResult != nil {
+			return bmfrr.Response[i].BunqMeFundraiserResult, nil
+		}
+	}
+
+	return nil, ErrBunqMeFundraiserResultNotFound
+}
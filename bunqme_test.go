@@ -0,0 +1,137 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateBunqMeTab(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "POST /v1/user/42/monetary-account/7/bunqme-tab"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if exp := `{"bunqme_tab_entry":{"amount_inquired":{"value":"12.50","currency":"EUR"},"description":"Order 1042","redirect_url":"https://shop.example.com/orders/1042"}}`; string(body) != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, string(body))
+		}
+		fmt.Fprintln(w, `{"Response":[{"Id":{"id":51}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.CreateBunqMeTab(context.Background(), 42, 7, &BunqMeTabParams{
+		Entry: BunqMeTabEntryParams{
+			AmountInquired: Amount{Units: 1250, Currency: "EUR"},
+			Description:    "Order 1042",
+			RedirectURL:    "https://shop.example.com/orders/1042",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 51 {
+		t.Errorf("Expected: `%v`, got: `%v`", 51, got)
+	}
+}
+
+func TestCancelBunqMeTab(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "PUT /v1/user/42/monetary-account/7/bunqme-tab/51"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if exp := `{"status":"CANCELLED"}`; string(body) != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, string(body))
+		}
+		fmt.Fprintln(w, `{"Response":[{"Id":{"id":51}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	if err := client.CancelBunqMeTab(context.Background(), 42, 7, 51); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetBunqMeTab(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "GET /v1/user/42/monetary-account/7/bunqme-tab/51"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		fmt.Fprintln(w, `{"Response":[{"BunqMeTab":{"id":51,"created":"2017-03-01 10:00:00.000000","updated":"2017-03-01 10:05:00.000000","time_expiry":"2017-03-22 10:00:00.000000","monetary_account_id":7,"status":"WAITING_FOR_PAYMENT","bunqme_tab_share_url":"https://bunq.me/t/6d8a3c","bunqme_tab_entry":{"uuid":"6d8a3c","amount_inquired":{"value":"12.50","currency":"EUR"},"description":"Order 1042","status":"WAITING_FOR_PAYMENT","redirect_url":"https://shop.example.com/orders/1042","merchant_available":[{"merchant_type":"IDEAL","available":true}]},"result_inquiries":[{"payment":{"id":1337},"bunq_me_tab_id":51}]}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.GetBunqMeTab(context.Background(), 42, 7, 51)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeExpiry := Time(time.Date(2017, 3, 22, 10, 0, 0, 0, time.UTC))
+	exp := &BunqMeTab{
+		ID:                51,
+		CreatedAt:         Time(time.Date(2017, 3, 1, 10, 0, 0, 0, time.UTC)),
+		UpdatedAt:         Time(time.Date(2017, 3, 1, 10, 5, 0, 0, time.UTC)),
+		TimeExpiry:        &timeExpiry,
+		MonetaryAccountID: 7,
+		Status:            BunqMeTabStatusWaitingForPayment,
+		ShareURL:          "https://bunq.me/t/6d8a3c",
+		Entry: BunqMeTabEntry{
+			UUID:              "6d8a3c",
+			AmountInquired:    Amount{Units: 1250, Currency: "EUR"},
+			Description:       "Order 1042",
+			Status:            BunqMeTabStatusWaitingForPayment,
+			RedirectURL:       "https://shop.example.com/orders/1042",
+			MerchantAvailable: []BunqMeMerchantAvailable{{MerchantType: "IDEAL", Available: true}},
+		},
+		ResultInquiries: []BunqMeTabResultInquiry{{Payment: &Payment{ID: 1337}, BunqMeTabID: 51}},
+	}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+}
+
+func TestGetBunqMeFundraiserResult(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "GET /v1/user/42/monetary-account/7/bunqme-fundraiser-result/61"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		fmt.Fprintln(w, `{"Response":[{"BunqMeFundraiserResult":{"id":61,"created":"2017-03-01 10:00:00.000000","updated":"2017-03-01 10:00:00.000000","bunqme_fundraiser_profile":{"color":"#FE2851","description":"Donations","status":"ACTIVE","pointer":{"type":"IBAN","value":"NL65BUNQ9876543210","name":"bunq"}},"payments":[{"id":1337}]}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.GetBunqMeFundraiserResult(context.Background(), 42, 7, 61)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := &BunqMeFundraiserResult{
+		ID:        61,
+		CreatedAt: Time(time.Date(2017, 3, 1, 10, 0, 0, 0, time.UTC)),
+		UpdatedAt: Time(time.Date(2017, 3, 1, 10, 0, 0, 0, time.UTC)),
+		BunqMeFundraiserProfile: BunqMeFundraiserProfile{
+			Color:       "#FE2851",
+			Description: "Donations",
+			Status:      "ACTIVE",
+			Pointer:     &Alias{Type: AliasTypeIBAN, Value: "NL65BUNQ9876543210", Name: "bunq"},
+		},
+		Payments: []*Payment{{ID: 1337}},
+	}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+}
@@ -0,0 +1,215 @@
+package bunq
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+// The statuses of a Card.
+const (
+	CardStatusActive      = "ACTIVE"
+	CardStatusDeactivated = "DEACTIVATED"
+	CardStatusLost        = "LOST"
+	CardStatusStolen      = "STOLEN"
+	CardStatusCancelled   = "CANCELLED"
+)
+
+// The types of a CardPinCodeAssignment.
+const (
+	PinCodeAssignmentPrimary   = "PRIMARY"
+	PinCodeAssignmentSecondary = "SECONDARY"
+	PinCodeAssignmentTertiary  = "TERTIARY"
+)
+
+// A CardPrimaryAccountNumber is a card number of a Card, of which only the
+// last four digits are exposed.
+type CardPrimaryAccountNumber struct {
+	ID                int    `json:"id"`
+	Description       string `json:"description"`
+	Status            string `json:"status"`
+	MonetaryAccountID int    `json:"monetary_account_id"`
+	FourDigit         string `json:"four_digit"`
+}
+
+// A CardCountryPermission allows a Card to be used in a country, until its
+// expiry time if set.
+type CardCountryPermission struct {
+	ID         int    `json:"id,omitempty"`
+	Country    string `json:"country"`
+	ExpiryTime *Time  `json:"expiry_time,omitempty"`
+}
+
+// A CardPinCodeAssignment assigns a monetary account to a PIN code of a Card.
+type CardPinCodeAssignment struct {
+	Type        string `json:"type"`
+	RoutingType string `json:"routing_type,omitempty"`
+	// PinCode is only sent when ordering a card. The API never returns it.
+	PinCode           string `json:"pin_code,omitempty"`
+	MonetaryAccountID int    `json:"monetary_account_id"`
+}
+
+// A Card is a debit or credit card of a user at the bunq API.
+type Card struct {
+	ID                          int                        `json:"id"`
+	CreatedAt                   Time                       `json:"created"`
+	UpdatedAt                   Time                       `json:"updated"`
+	PublicUUID                  string                     `json:"public_uuid"`
+	Type                        string                     `json:"type"`
+	SubType                     string                     `json:"sub_type"`
+	SecondLine                  string                     `json:"second_line"`
+	NameOnCard                  string                     `json:"name_on_card"`
+	Status                      string                     `json:"status"`
+	SubStatus                   string                     `json:"sub_status"`
+	OrderStatus                 string                     `json:"order_status"`
+	ExpiryDate                  *Time                      `json:"expiry_date"`
+	PrimaryAccountNumbers       []CardPrimaryAccountNumber `json:"primary_account_numbers"`
+	CardLimit                   *Amount                    `json:"card_limit"`
+	CardLimitATM                *Amount                    `json:"card_limit_atm"`
+	CountryPermission           []CardCountryPermission    `json:"country_permission"`
+	LabelMonetaryAccountOrdered *LabelMonetaryAccount      `json:"label_monetary_account_ordered"`
+	LabelMonetaryAccountCurrent *LabelMonetaryAccount      `json:"label_monetary_account_current"`
+	PinCodeAssignment           []CardPinCodeAssignment    `json:"pin_code_assignment"`
+	MonetaryAccountIDFallback   int                        `json:"monetary_account_id_fallback"`
+	Country                     string                     `json:"country"`
+}
+
+// PrimaryAccount returns the MonetaryAccountID of the primary PIN code
+// assignment of the card, or zero if there is none.
+func (card *Card) PrimaryAccount() int {
+	for _, assignment := range card.PinCodeAssignment {
+		if assignment.Type == PinCodeAssignmentPrimary {
+			return assignment.MonetaryAccountID
+		}
+	}
+
+	return 0
+}
+
+// CardParams are the fields for updating a Card. Only fields that are set are
+// updated.
+type CardParams struct {
+	PinCode                   string                  `json:"pin_code,omitempty"`
+	ActivationCode            string                  `json:"activation_code,omitempty"`
+	Status                    string                  `json:"status,omitempty"`
+	CardLimit                 *Amount                 `json:"card_limit,omitempty"`
+	CardLimitATM              *Amount                 `json:"card_limit_atm,omitempty"`
+	CountryPermission         []CardCountryPermission `json:"country_permission,omitempty"`
+	PinCodeAssignment         []CardPinCodeAssignment `json:"pin_code_assignment,omitempty"`
+	MonetaryAccountIDFallback int                     `json:"monetary_account_id_fallback,omitempty"`
+}
+
+type cardResponse struct {
+	Response []struct {
+		CardDebit  *Card `json:"CardDebit,omitempty"`
+		CardCredit *Card `json:"CardCredit,omitempty"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+func (cr *cardResponse) cards() []*Card {
+	var cards []*Card
+	for i := range cr.Response {
+		if cr.Response[i].CardDebit != nil {
+			cards = append(cards, cr.Response[i].CardDebit)
+			continue
+		}
+		if cr.Response[i].CardCredit != nil {
+			cards = append(cards, cr.Response[i].CardCredit)
+		}
+	}
+
+	return cards
+}
+
+// ErrCardNotFound is returned when a single Card resource was not found.
+var ErrCardNotFound = errors.New("card not found")
+
+func cardEndpoint(userID int) string {
+	return "user/" + strconv.Itoa(userID) + "/card"
+}
+
+// CardDebitParams are the fields for ordering a debit card.
+type CardDebitParams struct {
+	// Type is the type of card, such as "MAESTRO" or "MASTERCARD".
+	Type       string `json:"type"`
+	SubType    string `json:"sub_type,omitempty"`
+	NameOnCard string `json:"name_on_card"`
+	SecondLine string `json:"second_line"`
+	// Alias is the alias of the user that the card is ordered for.
+	Alias                     *Alias                  `json:"alias,omitempty"`
+	PinCodeAssignment         []CardPinCodeAssignment `json:"pin_code_assignment"`
+	MonetaryAccountIDFallback int                     `json:"monetary_account_id_fallback,omitempty"`
+}
+
+// CreateCardDebit orders a debit card for a user at the bunq API and returns
+// the new Card. The request body is encrypted, which requires the server
+// public key of the client.
+func (c *Client) CreateCardDebit(ctx context.Context, userID int, params *CardDebitParams) (*Card, error) {
+	var cr cardResponse
+	if err := c.doEncrypted(ctx, http.MethodPost, "user/"+strconv.Itoa(userID)+"/card-debit", params, &cr); err != nil {
+		return nil, err
+	}
+
+	cards := cr.cards()
+	if len(cards) == 0 {
+		return nil, errors.New("bunq: api response did not contain results")
+	}
+
+	return cards[0], nil
+}
+
+// UpdateCard updates a Card resource at the bunq API, e.g. to change its
+// status, limits, country permissions or PIN code assignments, and returns
+// the updated card. The request body is encrypted, which requires the server
+// public key of the client.
+func (c *Client) UpdateCard(ctx context.Context, userID, id int, params *CardParams) (*Card, error) {
+	var cr cardResponse
+	if err := c.doEncrypted(ctx, http.MethodPut, cardEndpoint(userID)+"/"+strconv.Itoa(id), params, &cr); err != nil {
+		return nil, err
+	}
+
+	cards := cr.cards()
+	if len(cards) == 0 {
+		return nil, errors.New("bunq: api response did not contain results")
+	}
+
+	return cards[0], nil
+}
+
+// GetCard gets a Card resource at the bunq API.
+func (c *Client) GetCard(ctx context.Context, userID, id int) (*Card, error) {
+	var cr cardResponse
+	if err := c.do(ctx, http.MethodGet, cardEndpoint(userID)+"/"+strconv.Itoa(id), nil, &cr); err != nil {
+		return nil, err
+	}
+
+	cards := cr.cards()
+	if len(cards) == 0 {
+		return nil, ErrCardNotFound
+	}
+
+	return cards[0], nil
+}
+
+// ListCards gets a page of Card resources of a user at the bunq API.
+func (c *Client) ListCards(ctx context.Context, userID int, opts *ListOptions) ([]*Card, error) {
+	cards, _, err := c.listCards(ctx, opts.endpoint(cardEndpoint(userID)))
+	return cards, err
+}
+
+// IterateCards returns an iterator over all Card resources of a user at the
+// bunq API.
+func (c *Client) IterateCards(userID int, opts *ListOptions) *Iterator[*Card] {
+	return newIterator(opts.endpoint(cardEndpoint(userID)), c.listCards)
+}
+
+func (c *Client) listCards(ctx context.Context, endpoint string) ([]*Card, Pagination, error) {
+	var cr cardResponse
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &cr); err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return cr.cards(), cr.Pagination, nil
+}
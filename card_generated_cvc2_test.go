@@ -0,0 +1,71 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateGeneratedCVC2(t *testing.T) {
+	var client *Client
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "POST /v1/user/42/card/71/generated-cvc2"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		got := string(decryptBody(t, client, r))
+		if exp := `{"type":"ONE_TIME"}`; got != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, got)
+		}
+		body := `{"Response":[{"Id":{"id":101}}]}`
+		writeSignedResponse(t, w, client, body, body)
+	}))
+	defer ts.Close()
+
+	client = newTestClient(t, ts.URL)
+	client.ServerPublicKey = &client.PrivateKey.PublicKey
+	client.InsecureSkipVerify = false
+
+	got, err := client.CreateGeneratedCVC2(context.Background(), 42, 71, CVC2TypeOneTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 101 {
+		t.Errorf("Expected: `%v`, got: `%v`", 101, got)
+	}
+}
+
+func TestGetGeneratedCVC2(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "GET /v1/user/42/card/71/generated-cvc2/101"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		fmt.Fprintln(w, `{"Response":[{"CardGeneratedCvc2":{"id":101,"created":"2017-03-01 10:00:00.000000","updated":"2017-03-01 10:00:00.000000","type":"ONE_TIME","cvc2":"042","status":"AVAILABLE","expiry_time":"2017-03-02 10:00:00.000000"}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.GetGeneratedCVC2(context.Background(), 42, 71, 101)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expiryTime := Time(time.Date(2017, 3, 2, 10, 0, 0, 0, time.UTC))
+	exp := &GeneratedCVC2{
+		ID:         101,
+		CreatedAt:  Time(time.Date(2017, 3, 1, 10, 0, 0, 0, time.UTC)),
+		UpdatedAt:  Time(time.Date(2017, 3, 1, 10, 0, 0, 0, time.UTC)),
+		Type:       CVC2TypeOneTime,
+		CVC2:       "042",
+		Status:     "AVAILABLE",
+		ExpiryTime: &expiryTime,
+	}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+}
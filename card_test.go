@@ -0,0 +1,153 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetCard(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "GET /v1/user/42/card/71"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		fmt.Fprintln(w, `{"Response":[{"CardDebit":{"id":71,"created":"2017-03-01 10:00:00.000000","updated":"2017-03-01 10:00:00.000000","public_uuid":"c1a5-9b30","type":"MAESTRO","sub_type":"NONE","second_line":"Acme","name_on_card":"K. DE VRIES","status":"ACTIVE","order_status":"ACCEPTED_FOR_PRODUCTION","expiry_date":"2021-03-31","primary_account_numbers":[{"id":81,"description":"Travel","status":"ACTIVE","monetary_account_id":7,"four_digit":"1234"}],"card_limit":{"value":"500.00","currency":"EUR"},"card_limit_atm":{"value":"250.00","currency":"EUR"},"country_permission":[{"id":91,"country":"NL","expiry_time":null}],"pin_code_assignment":[{"type":"PRIMARY","routing_type":"MANUAL","monetary_account_id":7}],"country":"NL"}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.GetCard(context.Background(), 42, 71)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expiryDate := Time(time.Date(2021, 3, 31, 0, 0, 0, 0, time.UTC))
+	exp := &Card{
+		ID:          71,
+		CreatedAt:   Time(time.Date(2017, 3, 1, 10, 0, 0, 0, time.UTC)),
+		UpdatedAt:   Time(time.Date(2017, 3, 1, 10, 0, 0, 0, time.UTC)),
+		PublicUUID:  "c1a5-9b30",
+		Type:        "MAESTRO",
+		SubType:     "NONE",
+		SecondLine:  "Acme",
+		NameOnCard:  "K. DE VRIES",
+		Status:      CardStatusActive,
+		OrderStatus: "ACCEPTED_FOR_PRODUCTION",
+		ExpiryDate:  &expiryDate,
+		PrimaryAccountNumbers: []CardPrimaryAccountNumber{
+			{ID: 81, Description: "Travel", Status: "ACTIVE", MonetaryAccountID: 7, FourDigit: "1234"},
+		},
+		CardLimit:         &Amount{Units: 50000, Currency: "EUR"},
+		CardLimitATM:      &Amount{Units: 25000, Currency: "EUR"},
+		CountryPermission: []CardCountryPermission{{ID: 91, Country: "NL"}},
+		PinCodeAssignment: []CardPinCodeAssignment{{Type: PinCodeAssignmentPrimary, RoutingType: "MANUAL", MonetaryAccountID: 7}},
+		Country:           "NL",
+	}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+	if got.PrimaryAccount() != 7 {
+		t.Errorf("Expected: `%v`, got: `%v`", 7, got.PrimaryAccount())
+	}
+}
+
+func TestUpdateCard(t *testing.T) {
+	var client *Client
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "PUT /v1/user/42/card/71"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		got := string(decryptBody(t, client, r))
+		if exp := `{"pin_code":"0123","status":"DEACTIVATED","card_limit":{"value":"100.00","currency":"EUR"},"country_permission":[{"country":"NL"},{"country":"BE"}],"pin_code_assignment":[{"type":"PRIMARY","monetary_account_id":8}]}`; got != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, got)
+		}
+		body := `{"Response":[{"CardDebit":{"id":71}}]}`
+		writeSignedResponse(t, w, client, body, body)
+	}))
+	defer ts.Close()
+
+	client = newTestClient(t, ts.URL)
+	client.ServerPublicKey = &client.PrivateKey.PublicKey
+	client.InsecureSkipVerify = false
+
+	got, err := client.UpdateCard(context.Background(), 42, 71, &CardParams{
+		PinCode:           "0123",
+		Status:            CardStatusDeactivated,
+		CardLimit:         &Amount{Units: 10000, Currency: "EUR"},
+		CountryPermission: []CardCountryPermission{{Country: "NL"}, {Country: "BE"}},
+		PinCodeAssignment: []CardPinCodeAssignment{{Type: PinCodeAssignmentPrimary, MonetaryAccountID: 8}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != 71 {
+		t.Errorf("Expected: `%v`, got: `%v`", 71, got.ID)
+	}
+}
+
+func TestCreateCardDebit(t *testing.T) {
+	var client *Client
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "POST /v1/user/42/card-debit"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		got := string(decryptBody(t, client, r))
+		if exp := `{"type":"MASTERCARD","sub_type":"VIRTUAL","name_on_card":"K. DE VRIES","second_line":"Acme","alias":{"type":"EMAIL","value":"kees@example.com","name":""},"pin_code_assignment":[{"type":"PRIMARY","pin_code":"0123","monetary_account_id":7}]}`; got != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, got)
+		}
+		body := `{"Response":[{"CardDebit":{"id":72,"type":"MASTERCARD","name_on_card":"K. DE VRIES"}}]}`
+		writeSignedResponse(t, w, client, body, body)
+	}))
+	defer ts.Close()
+
+	client = newTestClient(t, ts.URL)
+	client.ServerPublicKey = &client.PrivateKey.PublicKey
+	client.InsecureSkipVerify = false
+
+	got, err := client.CreateCardDebit(context.Background(), 42, &CardDebitParams{
+		Type:              "MASTERCARD",
+		SubType:           "VIRTUAL",
+		NameOnCard:        "K. DE VRIES",
+		SecondLine:        "Acme",
+		Alias:             &Alias{Type: AliasTypeEmail, Value: "kees@example.com"},
+		PinCodeAssignment: []CardPinCodeAssignment{{Type: PinCodeAssignmentPrimary, PinCode: "0123", MonetaryAccountID: 7}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := &Card{ID: 72, Type: "MASTERCARD", NameOnCard: "K. DE VRIES"}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+}
+
+func TestListCards(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "GET /v1/user/42/card"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		fmt.Fprintln(w, `{"Response":[{"CardDebit":{"id":71,"type":"MAESTRO"}},{"CardCredit":{"id":72,"type":"MASTERCARD"}}],"Pagination":{}}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.ListCards(context.Background(), 42, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := []*Card{{ID: 71, Type: "MAESTRO"}, {ID: 72, Type: "MASTERCARD"}}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+}
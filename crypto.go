@@ -1,21 +1,40 @@
 package bunq
 
 import (
+	"bytes"
+	"context"
 	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha1"
 	"crypto/sha256"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
 	"encoding/base64"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"sort"
+	"strconv"
+	"strings"
 )
 
+// ErrInvalidServerSignature is returned when the signature of an API response
+// could not be verified with the server public key.
+var ErrInvalidServerSignature = errors.New("bunq: invalid server signature")
+
+// ErrMissingServerPublicKey is returned when the signature of an API response
+// could not be verified, because the client has no server public key.
+var ErrMissingServerPublicKey = errors.New("bunq: no server public key to verify response signature")
+
 type header struct {
 	Key   string
 	Value string
@@ -67,34 +86,485 @@ func (c *Client) addSignature(req *http.Request, endpoint, body string) error {
 	return nil
 }
 
-// SetPrivateKey reads and parses private key data into a private key.
+// The headers of a request with an encrypted body.
+const (
+	headerEncryptionKey  = "X-Bunq-Client-Encryption-Key"
+	headerEncryptionIV   = "X-Bunq-Client-Encryption-Iv"
+	headerEncryptionHMAC = "X-Bunq-Client-Encryption-Hmac"
+)
+
+type bodyEncryptionKey struct{}
+
+// WithBodyEncryption returns a copy of ctx that makes the client encrypt the
+// body of requests sent with it, in the same way as is required by the API for
+// sensitive card endpoints. Encryption requires the server public key of the
+// client. Requests without body, and requests for the installation, device
+// server or session, are not affected.
+func WithBodyEncryption(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bodyEncryptionKey{}, true)
+}
+
+func bodyEncryption(ctx context.Context) bool {
+	enabled, _ := ctx.Value(bodyEncryptionKey{}).(bool)
+	return enabled
+}
+
+// encryptBody encrypts a request body with a random AES-256 key, as required
+// by the API for sensitive endpoints. It returns the encrypted body and the
+// headers that hold the key, wrapped with the server public key, the IV and
+// the HMAC of the encrypted body. Randomness is read from random.
+func (c *Client) encryptBody(random io.Reader, body []byte) ([]byte, http.Header, error) {
+	if c.ServerPublicKey == nil {
+		return nil, nil, errors.New("bunq: server public key is required for encrypting request body")
+	}
+
+	key := make([]byte, 32)
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(random, key); err != nil {
+		return nil, nil, fmt.Errorf("bunq: could not generate encryption key: %v", err)
+	}
+	if _, err := io.ReadFull(random, iv); err != nil {
+		return nil, nil, fmt.Errorf("bunq: could not generate encryption IV: %v", err)
+	}
+
+	encrypted, mac, err := sealBody(key, iv, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	wrappedKey, err := rsa.EncryptPKCS1v15(random, c.ServerPublicKey, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("bunq: could not encrypt encryption key: %v", err)
+	}
+
+	header := http.Header{}
+	header.Set(headerEncryptionKey, base64.StdEncoding.EncodeToString(wrappedKey))
+	header.Set(headerEncryptionIV, base64.StdEncoding.EncodeToString(iv))
+	header.Set(headerEncryptionHMAC, base64.StdEncoding.EncodeToString(mac))
+
+	return encrypted, header, nil
+}
+
+// sealBody encrypts body with AES-256-CBC and PKCS #7 padding, and returns the
+// encrypted body with its HMAC-SHA1 over the IV and the encrypted body.
+func sealBody(key, iv, body []byte) ([]byte, []byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("bunq: could not create cipher: %v", err)
+	}
+
+	padding := aes.BlockSize - len(body)%aes.BlockSize
+	encrypted := make([]byte, len(body), len(body)+padding)
+	copy(encrypted, body)
+	encrypted = append(encrypted, bytes.Repeat([]byte{byte(padding)}, padding)...)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, encrypted)
+
+	h := hmac.New(sha1.New, key)
+	h.Write(iv)
+	h.Write(encrypted)
+
+	return encrypted, h.Sum(nil), nil
+}
+
+// verifyResponse reads the body of resp into memory and verifies the response
+// signature over it. The body of resp is replaced so it can be read again.
+func (c *Client) verifyResponse(resp *http.Response) error {
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("bunq: could not read HTTP response: %v", err)
+	}
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	v, err := c.newResponseVerifier(resp)
+	if err != nil {
+		return err
+	}
+	v.hash.Write(body)
+
+	return v.verify()
+}
+
+// streamResponse is like verifyResponse, but streams the body of resp. The
+// signature is verified once the body is read to the end; instead of io.EOF,
+// reading then returns ErrInvalidServerSignature if it is invalid.
+func (c *Client) streamResponse(resp *http.Response) error {
+	v, err := c.newResponseVerifier(resp)
+	if err != nil {
+		return err
+	}
+	v.body = resp.Body
+	resp.Body = v
+
+	return nil
+}
+
+// responseVerifier verifies the signature of a response. It hashes the status
+// code and headers of the response when created, and the body as it is read.
+type responseVerifier struct {
+	pubKey    *rsa.PublicKey
+	signature []byte
+	hash      hash.Hash
+	body      io.ReadCloser
+	err       error
+}
+
+func (c *Client) newResponseVerifier(resp *http.Response) (*responseVerifier, error) {
+	signature, err := base64.StdEncoding.DecodeString(resp.Header.Get("X-Bunq-Server-Signature"))
+	if err != nil || len(signature) == 0 {
+		return nil, ErrInvalidServerSignature
+	}
+
+	var headers []header
+	for key, val := range resp.Header {
+		if !strings.HasPrefix(key, "X-Bunq-") || key == "X-Bunq-Server-Signature" {
+			continue
+		}
+		for j := range val {
+			headers = append(headers, header{
+				Key:   key,
+				Value: val[j],
+			})
+		}
+	}
+	sort.Sort(byKey(headers))
+
+	h := sha256.New()
+	io.WriteString(h, strconv.Itoa(resp.StatusCode)+"\n")
+	for i := range headers {
+		io.WriteString(h, headers[i].Key+": "+headers[i].Value+"\n")
+	}
+	io.WriteString(h, "\n")
+
+	return &responseVerifier{
+		pubKey:    c.ServerPublicKey,
+		signature: signature,
+		hash:      h,
+	}, nil
+}
+
+func (v *responseVerifier) verify() error {
+	if err := rsa.VerifyPKCS1v15(v.pubKey, crypto.SHA256, v.hash.Sum(nil), v.signature); err != nil {
+		return ErrInvalidServerSignature
+	}
+
+	return nil
+}
+
+// Read reads from the body and hashes the bytes read. At the end of the body,
+// it returns io.EOF only if the signature is valid.
+func (v *responseVerifier) Read(p []byte) (int, error) {
+	if v.err != nil {
+		return 0, v.err
+	}
+	n, err := v.body.Read(p)
+	v.hash.Write(p[:n])
+	if err == io.EOF {
+		if err = v.verify(); err == nil {
+			err = io.EOF
+		}
+	}
+	v.err = err
+
+	return n, err
+}
+
+// Close closes the body.
+func (v *responseVerifier) Close() error {
+	return v.body.Close()
+}
+
+// MinKeySize is the minimum size in bits of the RSA keys accepted by the bunq
+// API.
+const MinKeySize = 2048
+
+// GeneratePrivateKey generates an RSA private key of the given size in bits,
+// which must be at least MinKeySize.
+func GeneratePrivateKey(bits int) (*rsa.PrivateKey, error) {
+	if bits < MinKeySize {
+		return nil, fmt.Errorf("bunq: key size must be at least %v bits", MinKeySize)
+	}
+	privKey, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return nil, fmt.Errorf("bunq: could not generate private key: %v", err)
+	}
+
+	return privKey, nil
+}
+
+// SetPrivateKey reads and parses private key data into a private key. The PEM
+// data must contain either a PKCS #1 `RSA PRIVATE KEY` block or a PKCS #8
+// `PRIVATE KEY` block.
 func (c *Client) SetPrivateKey(r io.Reader) error {
+	return c.SetEncryptedPrivateKey(r, nil)
+}
+
+// SetEncryptedPrivateKey is like SetPrivateKey, but also accepts PEM blocks
+// that are encrypted with password: encrypted PKCS #8 `ENCRYPTED PRIVATE KEY`
+// blocks using PBES2 with PBKDF2 and AES-CBC, as created by
+// `openssl genrsa -aes256` since OpenSSL 3.0, and legacy encrypted PEM blocks,
+// as created by earlier versions.
+func (c *Client) SetEncryptedPrivateKey(r io.Reader, password []byte) error {
 	pemData, err := ioutil.ReadAll(r)
 	if err != nil {
 		return fmt.Errorf("bunq: error reading PEM data: %v", err)
 	}
 
+	privKey, err := parsePrivateKey(pemData, password)
+	if err != nil {
+		return err
+	}
+	c.PrivateKey = privKey
+
+	return nil
+}
+
+// PrivateKeyPEM returns the private key of the client as a PKCS #1 PEM block.
+func (c *Client) PrivateKeyPEM() ([]byte, error) {
+	if c.PrivateKey == nil {
+		return nil, errors.New("bunq: private key cannot be nil")
+	}
+
+	return encodePrivateKey(c.PrivateKey), nil
+}
+
+// PublicKeyPEM returns the public key of the client as a PKIX PEM block.
+func (c *Client) PublicKeyPEM() ([]byte, error) {
+	publicKey, err := c.publicKey()
+	if err != nil {
+		return nil, fmt.Errorf("bunq: %v", err)
+	}
+
+	return publicKey, nil
+}
+
+func parsePrivateKey(pemData, password []byte) (*rsa.PrivateKey, error) {
 	pemBlock, _ := pem.Decode(pemData)
 	if pemBlock == nil {
-		return errors.New("bunq: no PEM block found in data")
+		return nil, errors.New("bunq: no PEM block found in data")
+	}
+
+	der := pemBlock.Bytes
+	encrypted := x509.IsEncryptedPEMBlock(pemBlock) || pemBlock.Type == "ENCRYPTED PRIVATE KEY"
+	if encrypted && password == nil {
+		return nil, errors.New("bunq: PEM block is encrypted, but no password was given")
+	}
+	if x509.IsEncryptedPEMBlock(pemBlock) {
+		var err error
+		if der, err = x509.DecryptPEMBlock(pemBlock, password); err != nil {
+			return nil, fmt.Errorf("bunq: error decrypting PEM block: %v", err)
+		}
+	}
+
+	switch pemBlock.Type {
+	case "RSA PRIVATE KEY":
+		privKey, err := x509.ParsePKCS1PrivateKey(der)
+		if err != nil {
+			return nil, fmt.Errorf("bunq: error parsing PEM block into private key")
+		}
+		return privKey, nil
+	case "ENCRYPTED PRIVATE KEY":
+		var err error
+		if der, err = decryptPKCS8(der, password); err != nil {
+			return nil, fmt.Errorf("bunq: error decrypting PEM block: %v", err)
+		}
+		fallthrough
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(der)
+		if err != nil {
+			return nil, fmt.Errorf("bunq: error parsing PEM block into private key")
+		}
+		privKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, errors.New("bunq: private key is not an RSA key")
+		}
+		return privKey, nil
+	default:
+		return nil, errors.New("bunq: invalid key type found, expected `RSA PRIVATE KEY`, `PRIVATE KEY` or `ENCRYPTED PRIVATE KEY`")
+	}
+}
+
+var (
+	oidPBES2          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidHMACWithSHA1   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidAES128CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+)
+
+// encryptedPrivateKeyInfo is defined in RFC 5208, section 6.
+type encryptedPrivateKeyInfo struct {
+	Algorithm     pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+// pbes2Params is defined in RFC 8018, appendix A.4.
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+// pbkdf2Params is defined in RFC 8018, appendix A.2.
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// decryptPKCS8 decrypts an encrypted PKCS #8 private key, which must be
+// encrypted with PBES2, using PBKDF2 with HMAC-SHA1 or HMAC-SHA256 and AES-CBC.
+func decryptPKCS8(der, password []byte) ([]byte, error) {
+	var info encryptedPrivateKeyInfo
+	if _, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, fmt.Errorf("invalid encrypted private key: %v", err)
+	}
+	if !info.Algorithm.Algorithm.Equal(oidPBES2) {
+		return nil, fmt.Errorf("unsupported encryption algorithm %v", info.Algorithm.Algorithm)
+	}
+	var params pbes2Params
+	if _, err := asn1.Unmarshal(info.Algorithm.Parameters.FullBytes, &params); err != nil {
+		return nil, fmt.Errorf("invalid PBES2 parameters: %v", err)
+	}
+
+	if !params.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+		return nil, fmt.Errorf("unsupported key derivation function %v", params.KeyDerivationFunc.Algorithm)
+	}
+	var kdfParams pbkdf2Params
+	if _, err := asn1.Unmarshal(params.KeyDerivationFunc.Parameters.FullBytes, &kdfParams); err != nil {
+		return nil, fmt.Errorf("invalid PBKDF2 parameters: %v", err)
+	}
+	prf := sha1.New
+	switch alg := kdfParams.PRF.Algorithm; {
+	case len(alg) == 0, alg.Equal(oidHMACWithSHA1):
+	case alg.Equal(oidHMACWithSHA256):
+		prf = sha256.New
+	default:
+		return nil, fmt.Errorf("unsupported pseudorandom function %v", alg)
+	}
+
+	var keyLen int
+	switch alg := params.EncryptionScheme.Algorithm; {
+	case alg.Equal(oidAES128CBC):
+		keyLen = 16
+	case alg.Equal(oidAES192CBC):
+		keyLen = 24
+	case alg.Equal(oidAES256CBC):
+		keyLen = 32
+	default:
+		return nil, fmt.Errorf("unsupported encryption scheme %v", alg)
+	}
+	var iv []byte
+	if _, err := asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv); err != nil || len(iv) != aes.BlockSize {
+		return nil, errors.New("invalid AES-CBC parameters")
 	}
-	if pemBlock.Type != "RSA PRIVATE KEY" {
-		return errors.New("bunq: invalid key type found, expected `RSA PRIVATE KEY`")
+	if kdfParams.IterationCount < 1 || kdfParams.KeyLength != 0 && kdfParams.KeyLength != keyLen {
+		return nil, errors.New("invalid PBKDF2 parameters")
 	}
-	privKey, err := x509.ParsePKCS1PrivateKey(pemBlock.Bytes)
+
+	block, err := aes.NewCipher(pbkdf2(prf, password, kdfParams.Salt, kdfParams.IterationCount, keyLen))
 	if err != nil {
-		return fmt.Errorf("bunq: error parsing PEM block into private key")
+		return nil, err
 	}
-	c.PrivateKey = privKey
+	data := info.EncryptedData
+	if len(data) == 0 || len(data)%aes.BlockSize != 0 {
+		return nil, errors.New("invalid encrypted data length")
+	}
+	decrypted := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(decrypted, data)
+
+	padding := int(decrypted[len(decrypted)-1])
+	if padding == 0 || padding > aes.BlockSize {
+		return nil, x509.IncorrectPasswordError
+	}
+	for _, b := range decrypted[len(decrypted)-padding:] {
+		if int(b) != padding {
+			return nil, x509.IncorrectPasswordError
+		}
+	}
+
+	return decrypted[:len(decrypted)-padding], nil
+}
+
+// pbkdf2 derives a key of keyLen bytes from password and salt, as defined in
+// RFC 8018, section 5.2.
+func pbkdf2(prf func() hash.Hash, password, salt []byte, iter, keyLen int) []byte {
+	h := hmac.New(prf, password)
+	var key []byte
+	for i := uint32(1); len(key) < keyLen; i++ {
+		h.Reset()
+		h.Write(salt)
+		h.Write([]byte{byte(i >> 24), byte(i >> 16), byte(i >> 8), byte(i)})
+		u := h.Sum(nil)
+		t := append([]byte(nil), u...)
+		for n := 1; n < iter; n++ {
+			h.Reset()
+			h.Write(u)
+			u = h.Sum(u[:0])
+			for j := range t {
+				t[j] ^= u[j]
+			}
+		}
+		key = append(key, t...)
+	}
+
+	return key[:keyLen]
+}
+
+func encodePrivateKey(privKey *rsa.PrivateKey) []byte {
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privKey),
+	})
+}
+
+// SetServerPublicKey reads and parses PEM data into the server public key that
+// is used to verify the signatures of API responses.
+func (c *Client) SetServerPublicKey(r io.Reader) error {
+	pemData, err := ioutil.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("bunq: error reading PEM data: %v", err)
+	}
+	pubKey, err := parsePublicKey(pemData)
+	if err != nil {
+		return err
+	}
+	c.ServerPublicKey = pubKey
 
 	return nil
 }
 
+func parsePublicKey(pemData []byte) (*rsa.PublicKey, error) {
+	pemBlock, _ := pem.Decode(pemData)
+	if pemBlock == nil {
+		return nil, errors.New("bunq: no PEM block found in data")
+	}
+	if pemBlock.Type != "PUBLIC KEY" {
+		return nil, errors.New("bunq: invalid key type found, expected `PUBLIC KEY`")
+	}
+	key, err := x509.ParsePKIXPublicKey(pemBlock.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("bunq: error parsing PEM block into public key: %v", err)
+	}
+	pubKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("bunq: public key is not an RSA key")
+	}
+
+	return pubKey, nil
+}
+
 func (c *Client) publicKey() ([]byte, error) {
 	if c.PrivateKey == nil {
 		return nil, errors.New("private key cannot be nil")
 	}
-	pubKeyDer, err := x509.MarshalPKIXPublicKey(c.PrivateKey.Public())
+
+	return encodePublicKey(&c.PrivateKey.PublicKey)
+}
+
+func encodePublicKey(pubKey *rsa.PublicKey) ([]byte, error) {
+	pubKeyDer, err := x509.MarshalPKIXPublicKey(pubKey)
 	if err != nil {
 		return nil, fmt.Errorf("error serializing public key to DER-encoded PKIX format: %v", err)
 	}
@@ -1,7 +1,7 @@
 package bunq
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
 	"net"
@@ -30,6 +30,7 @@ type deviceResponse struct {
 			Status      string `json:"status"`
 		} `json:"DeviceServer,omitempty"`
 	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
 }
 
 // A DevicePhone represents a Device at the bunq API.
@@ -48,32 +49,10 @@ type DevicePhone struct {
 var ErrDeviceNotFound = errors.New("device not found")
 
 // GetDevice gets a Device resource at the bunq API.
-func (c *Client) GetDevice(id int) (interface{}, error) {
-	httpMethod := http.MethodGet
-	endpoint := apiVersion + "/device/" + strconv.Itoa(id)
-	req, err := http.NewRequest(httpMethod, fmt.Sprintf("%v/%v", c.BaseURL, endpoint), nil)
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not create new request: %v", err)
-	}
-	setCommonHeaders(req)
-	req.Header.Set("X-Bunq-Client-Authentication", c.Token)
-	if err = c.addSignature(req, fmt.Sprintf("%v /%v", httpMethod, endpoint), ""); err != nil {
-		return nil, fmt.Errorf("bunq: could not add signature: %v", err)
-	}
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not send HTTP request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("bunq: request was unsuccessful: %v", decodeError(resp.Body))
-	}
-
+func (c *Client) GetDevice(ctx context.Context, id int) (interface{}, error) {
 	var devResp deviceResponse
-	if err = json.NewDecoder(resp.Body).Decode(&devResp); err != nil {
-		return nil, fmt.Errorf("bunq: could not decode HTTP response: %v", err)
+	if err := c.do(ctx, http.MethodGet, "device/"+strconv.Itoa(id), nil, &devResp); err != nil {
+		return nil, err
 	}
 
 	devices, err := devResp.devices()
@@ -87,41 +66,29 @@ func (c *Client) GetDevice(id int) (interface{}, error) {
 	return devices[0], nil
 }
 
-// ListDevices gets a list of Device resources at the bunq API.
-func (c *Client) ListDevices() ([]interface{}, error) {
-	httpMethod := http.MethodGet
-	endpoint := apiVersion + "/device"
-	req, err := http.NewRequest(httpMethod, fmt.Sprintf("%v/%v", c.BaseURL, endpoint), nil)
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not create new request: %v", err)
-	}
-	setCommonHeaders(req)
-	req.Header.Set("X-Bunq-Client-Authentication", c.Token)
-	if err = c.addSignature(req, fmt.Sprintf("%v /%v", httpMethod, endpoint), ""); err != nil {
-		return nil, fmt.Errorf("bunq: could not add signature: %v", err)
-	}
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not send HTTP request: %v", err)
-	}
-	defer resp.Body.Close()
+// ListDevices gets a page of Device resources at the bunq API.
+func (c *Client) ListDevices(ctx context.Context, opts *ListOptions) ([]interface{}, error) {
+	devices, _, err := c.listDevices(ctx, opts.endpoint("device"))
+	return devices, err
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("bunq: request was unsuccessful: %v", decodeError(resp.Body))
-	}
+// IterateDevices returns an iterator over all Device resources at the bunq API.
+func (c *Client) IterateDevices(opts *ListOptions) *Iterator[interface{}] {
+	return newIterator(opts.endpoint("device"), c.listDevices)
+}
 
+func (c *Client) listDevices(ctx context.Context, endpoint string) ([]interface{}, Pagination, error) {
 	var devResp deviceResponse
-	if err = json.NewDecoder(resp.Body).Decode(&devResp); err != nil {
-		return nil, fmt.Errorf("bunq: could not decode HTTP response: %v", err)
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &devResp); err != nil {
+		return nil, Pagination{}, err
 	}
 
 	devices, err := devResp.devices()
 	if err != nil {
-		return nil, fmt.Errorf("bunq: could not parse API response: %v", err)
+		return nil, Pagination{}, fmt.Errorf("bunq: could not parse API response: %v", err)
 	}
 
-	return devices, nil
+	return devices, devResp.Pagination, nil
 }
 
 func (devResp *deviceResponse) devices() ([]interface{}, error) {
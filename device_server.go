@@ -1,8 +1,7 @@
 package bunq
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
 	"net"
@@ -25,6 +24,7 @@ type deviceServerResponse struct {
 			Status      string `json:"status"`
 		} `json:"DeviceServer,omitempty"`
 	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
 }
 
 // A DeviceServer represents a DeviceServe at the bunq API.
@@ -41,8 +41,9 @@ type DeviceServer struct {
 // not found.
 var ErrDeviceServerNotFound = errors.New("device server not found")
 
-// CreateDeviceServer creates a DeviceServer resource at the bunq API.
-func (c *Client) CreateDeviceServer(description string, permittedIPs []net.IP) (*DeviceServer, error) {
+// CreateDeviceServer creates a DeviceServer resource at the bunq API. The
+// request is authenticated with the installation token of the client, if set.
+func (c *Client) CreateDeviceServer(ctx context.Context, description string, permittedIPs []net.IP) (*DeviceServer, error) {
 	var ips []string
 	for i := range permittedIPs {
 		ips = append(ips, permittedIPs[i].String())
@@ -53,36 +54,9 @@ func (c *Client) CreateDeviceServer(description string, permittedIPs []net.IP) (
 		PermittedIPs []string `json:"permitted_ips,omitempty"`
 	}{description, c.APIKey, ips}
 
-	bodyJSON, err := json.Marshal(body)
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not encode request body into JSON: %v", err)
-	}
-
-	httpMethod := http.MethodPost
-	endpoint := apiVersion + "/device-server"
-	req, err := http.NewRequest(httpMethod, fmt.Sprintf("%v/%v", c.BaseURL, endpoint), bytes.NewReader(bodyJSON))
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not create new request: %v", err)
-	}
-	setCommonHeaders(req)
-	req.Header.Set("X-Bunq-Client-Authentication", c.Token)
-	if err = c.addSignature(req, fmt.Sprintf("%v /%v", httpMethod, endpoint), string(bodyJSON)); err != nil {
-		return nil, fmt.Errorf("bunq: could not add signature: %v", err)
-	}
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not send HTTP request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("bunq: request was unsuccessful: %v", decodeError(resp.Body))
-	}
-
 	var dsr deviceServerResponse
-	if err = json.NewDecoder(resp.Body).Decode(&dsr); err != nil {
-		return nil, fmt.Errorf("bunq: could not decode HTTP response: %v", err)
+	if err := c.doInstallation(ctx, http.MethodPost, "device-server", body, &dsr); err != nil {
+		return nil, err
 	}
 
 	deviceServers, err := dsr.deviceServers()
@@ -97,32 +71,10 @@ func (c *Client) CreateDeviceServer(description string, permittedIPs []net.IP) (
 }
 
 // GetDeviceServer gets a DeviceServer resource at the bunq API.
-func (c *Client) GetDeviceServer(id int) (*DeviceServer, error) {
-	httpMethod := http.MethodGet
-	endpoint := apiVersion + "/device-server/" + strconv.Itoa(id)
-	req, err := http.NewRequest(httpMethod, fmt.Sprintf("%v/%v", c.BaseURL, endpoint), nil)
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not create new request: %v", err)
-	}
-	setCommonHeaders(req)
-	req.Header.Set("X-Bunq-Client-Authentication", c.Token)
-	if err = c.addSignature(req, fmt.Sprintf("%v /%v", httpMethod, endpoint), ""); err != nil {
-		return nil, fmt.Errorf("bunq: could not add signature: %v", err)
-	}
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not send HTTP request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("bunq: request was unsuccessful: %v", decodeError(resp.Body))
-	}
-
+func (c *Client) GetDeviceServer(ctx context.Context, id int) (*DeviceServer, error) {
 	var dsr deviceServerResponse
-	if err = json.NewDecoder(resp.Body).Decode(&dsr); err != nil {
-		return nil, fmt.Errorf("bunq: could not decode HTTP response: %v", err)
+	if err := c.do(ctx, http.MethodGet, "device-server/"+strconv.Itoa(id), nil, &dsr); err != nil {
+		return nil, err
 	}
 
 	deviceServers, err := dsr.deviceServers()
@@ -136,41 +88,30 @@ func (c *Client) GetDeviceServer(id int) (*DeviceServer, error) {
 	return deviceServers[0], nil
 }
 
-// ListDeviceServers gets a list of DeviceServer resources at the bunq API.
-func (c *Client) ListDeviceServers() ([]*DeviceServer, error) {
-	httpMethod := http.MethodGet
-	endpoint := apiVersion + "/device-server"
-	req, err := http.NewRequest(httpMethod, fmt.Sprintf("%v/%v", c.BaseURL, endpoint), nil)
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not create new request: %v", err)
-	}
-	setCommonHeaders(req)
-	req.Header.Set("X-Bunq-Client-Authentication", c.Token)
-	if err = c.addSignature(req, fmt.Sprintf("%v /%v", httpMethod, endpoint), ""); err != nil {
-		return nil, fmt.Errorf("bunq: could not add signature: %v", err)
-	}
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not send HTTP request: %v", err)
-	}
-	defer resp.Body.Close()
+// ListDeviceServers gets a page of DeviceServer resources at the bunq API.
+func (c *Client) ListDeviceServers(ctx context.Context, opts *ListOptions) ([]*DeviceServer, error) {
+	deviceServers, _, err := c.listDeviceServers(ctx, opts.endpoint("device-server"))
+	return deviceServers, err
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("bunq: request was unsuccessful: %v", decodeError(resp.Body))
-	}
+// IterateDeviceServers returns an iterator over all DeviceServer resources at
+// the bunq API.
+func (c *Client) IterateDeviceServers(opts *ListOptions) *Iterator[*DeviceServer] {
+	return newIterator(opts.endpoint("device-server"), c.listDeviceServers)
+}
 
+func (c *Client) listDeviceServers(ctx context.Context, endpoint string) ([]*DeviceServer, Pagination, error) {
 	var dsr deviceServerResponse
-	if err = json.NewDecoder(resp.Body).Decode(&dsr); err != nil {
-		return nil, fmt.Errorf("bunq: could not decode HTTP response: %v", err)
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &dsr); err != nil {
+		return nil, Pagination{}, err
 	}
 
 	deviceServers, err := dsr.deviceServers()
 	if err != nil {
-		return nil, fmt.Errorf("bunq: could not parse API response: %v", err)
+		return nil, Pagination{}, fmt.Errorf("bunq: could not parse API response: %v", err)
 	}
 
-	return deviceServers, nil
+	return deviceServers, dsr.Pagination, nil
 }
 
 func (dsr *deviceServerResponse) deviceServers() ([]*DeviceServer, error) {
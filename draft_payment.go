@@ -0,0 +1,192 @@
+package bunq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// The statuses of a DraftPayment. Accepted, rejected and cancelled drafts are
+// final.
+const (
+	DraftPaymentStatusPending   = "PENDING"
+	DraftPaymentStatusAccepted  = "ACCEPTED"
+	DraftPaymentStatusRejected  = "REJECTED"
+	DraftPaymentStatusCancelled = "CANCELLED"
+)
+
+// A DraftPaymentEntry is a single payment of a DraftPayment.
+type DraftPaymentEntry struct {
+	ID                int                  `json:"id"`
+	Amount            Amount               `json:"amount"`
+	Alias             LabelMonetaryAccount `json:"alias"`
+	CounterpartyAlias LabelMonetaryAccount `json:"counterparty_alias"`
+	Description       string               `json:"description"`
+	MerchantReference string               `json:"merchant_reference"`
+	Type              string               `json:"type"`
+	Attachment        []PaymentAttachment  `json:"attachment"`
+}
+
+// DraftPaymentResponse is the response of a user to a DraftPayment.
+type DraftPaymentResponse struct {
+	Status    string    `json:"status"`
+	UserAlias LabelUser `json:"user_alias_created"`
+}
+
+// DraftPaymentObject holds the Payment or PaymentBatch that was created for
+// an accepted DraftPayment.
+type DraftPaymentObject struct {
+	Payment      *Payment      `json:"Payment,omitempty"`
+	PaymentBatch *PaymentBatch `json:"PaymentBatch,omitempty"`
+}
+
+// A DraftPayment is a payment, or batch of payments, that is executed after it
+// has been accepted in the bunq app.
+type DraftPayment struct {
+	ID                int                    `json:"id"`
+	CreatedAt         Time                   `json:"created"`
+	UpdatedAt         Time                   `json:"updated"`
+	MonetaryAccountID int                    `json:"monetary_account_id"`
+	UserAliasCreated  LabelUser              `json:"user_alias_created"`
+	Responses         []DraftPaymentResponse `json:"responses"`
+	Status            string                 `json:"status"`
+	Type              string                 `json:"type"`
+	Entries           []DraftPaymentEntry    `json:"entries"`
+	Object            *DraftPaymentObject    `json:"object"`
+}
+
+// IsFinal reports whether the status of the draft payment can no longer
+// change.
+func (dp *DraftPayment) IsFinal() bool {
+	switch dp.Status {
+	case DraftPaymentStatusAccepted, DraftPaymentStatusRejected, DraftPaymentStatusCancelled:
+		return true
+	}
+
+	return false
+}
+
+// DraftPaymentEntryParams are the fields of a single payment of a
+// DraftPayment.
+type DraftPaymentEntryParams struct {
+	Amount            Amount              `json:"amount"`
+	CounterpartyAlias Alias               `json:"counterparty_alias"`
+	Description       string              `json:"description"`
+	Attachment        []PaymentAttachment `json:"attachment,omitempty"`
+	MerchantReference string              `json:"merchant_reference,omitempty"`
+}
+
+// DraftPaymentParams are the fields for creating or updating a DraftPayment.
+// Set Status to DraftPaymentStatusCancelled to cancel a pending draft.
+type DraftPaymentParams struct {
+	Status  string                    `json:"status,omitempty"`
+	Entries []DraftPaymentEntryParams `json:"entries,omitempty"`
+	// PreviousUpdatedTimestamp makes an update fail if the draft was changed
+	// since it was updated at the given time.
+	PreviousUpdatedTimestamp *Time `json:"previous_updated_timestamp,omitempty"`
+	// NumberOfRequiredAccepts is the number of users that need to accept the
+	// draft before it is executed.
+	NumberOfRequiredAccepts int `json:"number_of_required_accepts,omitempty"`
+}
+
+type draftPaymentResponse struct {
+	Response []struct {
+		DraftPayment *DraftPayment `json:"DraftPayment,omitempty"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+func (dpr *draftPaymentResponse) draftPayments() []*DraftPayment {
+	var draftPayments []*DraftPayment
+	for i := range dpr.Response {
+		if dpr.Response[i].DraftPayment != nil {
+			draftPayments = append(draftPayments, dpr.Response[i].DraftPayment)
+		}
+	}
+
+	return draftPayments
+}
+
+// ErrDraftPaymentNotFound is returned when a single DraftPayment resource was
+// not found.
+var ErrDraftPaymentNotFound = errors.New("draft payment not found")
+
+func draftPaymentEndpoint(userID, monetaryAccountID int) string {
+	return fmt.Sprintf("user/%v/monetary-account/%v/draft-payment", userID, monetaryAccountID)
+}
+
+// CreateDraftPayment creates a DraftPayment resource from a monetary account
+// at the bunq API and returns its ID.
+func (c *Client) CreateDraftPayment(ctx context.Context, userID, monetaryAccountID int, params *DraftPaymentParams) (int, error) {
+	return c.doID(ctx, http.MethodPost, draftPaymentEndpoint(userID, monetaryAccountID), params)
+}
+
+// UpdateDraftPayment updates a DraftPayment resource at the bunq API.
+func (c *Client) UpdateDraftPayment(ctx context.Context, userID, monetaryAccountID, id int, params *DraftPaymentParams) error {
+	_, err := c.doID(ctx, http.MethodPut, draftPaymentEndpoint(userID, monetaryAccountID)+"/"+strconv.Itoa(id), params)
+	return err
+}
+
+// GetDraftPayment gets a DraftPayment resource at the bunq API.
+func (c *Client) GetDraftPayment(ctx context.Context, userID, monetaryAccountID, id int) (*DraftPayment, error) {
+	var dpr draftPaymentResponse
+	endpoint := draftPaymentEndpoint(userID, monetaryAccountID) + "/" + strconv.Itoa(id)
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &dpr); err != nil {
+		return nil, err
+	}
+
+	draftPayments := dpr.draftPayments()
+	if len(draftPayments) == 0 {
+		return nil, ErrDraftPaymentNotFound
+	}
+
+	return draftPayments[0], nil
+}
+
+// WaitForDraftPayment gets a DraftPayment resource at the bunq API every
+// interval, until its status is final or ctx is done. The interval must be
+// positive.
+func (c *Client) WaitForDraftPayment(ctx context.Context, userID, monetaryAccountID, id int, interval time.Duration) (*DraftPayment, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("bunq: invalid interval for waiting for draft payment: %v", interval)
+	}
+
+	for {
+		draftPayment, err := c.GetDraftPayment(ctx, userID, monetaryAccountID, id)
+		if err != nil {
+			return nil, err
+		}
+		if draftPayment.IsFinal() {
+			return draftPayment, nil
+		}
+
+		if err = sleep(ctx, interval); err != nil {
+			return nil, fmt.Errorf("bunq: could not wait for draft payment: %w", err)
+		}
+	}
+}
+
+// ListDraftPayments gets a page of DraftPayment resources of a monetary
+// account at the bunq API.
+func (c *Client) ListDraftPayments(ctx context.Context, userID, monetaryAccountID int, opts *ListOptions) ([]*DraftPayment, error) {
+	draftPayments, _, err := c.listDraftPayments(ctx, opts.endpoint(draftPaymentEndpoint(userID, monetaryAccountID)))
+	return draftPayments, err
+}
+
+// IterateDraftPayments returns an iterator over all DraftPayment resources of
+// a monetary account at the bunq API.
+func (c *Client) IterateDraftPayments(userID, monetaryAccountID int, opts *ListOptions) *Iterator[*DraftPayment] {
+	return newIterator(opts.endpoint(draftPaymentEndpoint(userID, monetaryAccountID)), c.listDraftPayments)
+}
+
+func (c *Client) listDraftPayments(ctx context.Context, endpoint string) ([]*DraftPayment, Pagination, error) {
+	var dpr draftPaymentResponse
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &dpr); err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return dpr.draftPayments(), dpr.Pagination, nil
+}
@@ -0,0 +1,116 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateDraftPayment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "POST /v1/user/42/monetary-account/7/draft-payment"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if exp := `{"entries":[{"amount":{"value":"12.50","currency":"EUR"},"counterparty_alias":{"type":"IBAN","value":"NL02BUNQ0123456789","name":"Kees"},"description":"Invoice 2017-042"}],"number_of_required_accepts":2}`; string(body) != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, string(body))
+		}
+		fmt.Fprintln(w, `{"Response":[{"Id":{"id":3}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.CreateDraftPayment(context.Background(), 42, 7, &DraftPaymentParams{
+		Entries: []DraftPaymentEntryParams{{
+			Amount:            Amount{Units: 1250, Currency: "EUR"},
+			CounterpartyAlias: Alias{Type: AliasTypeIBAN, Value: "NL02BUNQ0123456789", Name: "Kees"},
+			Description:       "Invoice 2017-042",
+		}},
+		NumberOfRequiredAccepts: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 3 {
+		t.Errorf("Expected: `%v`, got: `%v`", 3, got)
+	}
+}
+
+func TestUpdateDraftPayment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "PUT /v1/user/42/monetary-account/7/draft-payment/3"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if exp := `{"status":"CANCELLED"}`; string(body) != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, string(body))
+		}
+		fmt.Fprintln(w, `{"Response":[{"Id":{"id":3}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	params := &DraftPaymentParams{Status: DraftPaymentStatusCancelled}
+	if err := client.UpdateDraftPayment(context.Background(), 42, 7, 3, params); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWaitForDraftPayment(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "GET /v1/user/42/monetary-account/7/draft-payment/3"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		calls++
+		status := DraftPaymentStatusPending
+		if calls == 2 {
+			status = DraftPaymentStatusAccepted
+		}
+		fmt.Fprintf(w, `{"Response":[{"DraftPayment":{"id":3,"status":%q,"entries":[],"object":{"Payment":{"id":1337}}}}]}`, status)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.WaitForDraftPayment(context.Background(), 42, 7, 3, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := &DraftPayment{
+		ID:      3,
+		Status:  DraftPaymentStatusAccepted,
+		Entries: []DraftPaymentEntry{},
+		Object:  &DraftPaymentObject{Payment: &Payment{ID: 1337}},
+	}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+	if calls != 2 {
+		t.Errorf("Expected: `%v`, got: `%v`", 2, calls)
+	}
+
+	calls = 0
+	if _, err = client.WaitForDraftPayment(context.Background(), 42, 7, 3, 0); err == nil {
+		t.Error("Expected error for non-positive interval.")
+	}
+	if calls != 0 {
+		t.Errorf("Expected: `%v` calls, got: `%v`", 0, calls)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	calls = -100
+	if _, err = client.WaitForDraftPayment(ctx, 42, 7, 3, time.Millisecond); err == nil {
+		t.Error("Expected error after context deadline.")
+	}
+}
@@ -2,15 +2,17 @@ package bunq
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"strings"
 )
 
 // Error represents an error returned by the bunq API.
 type Error struct {
-	ErrorDescription           string
-	ErrorDescriptionTranslated string
+	ErrorDescription           string `json:"error_description"`
+	ErrorDescriptionTranslated string `json:"error_description_translated"`
 }
 
 // Errors is an array of Error structs.
@@ -25,7 +27,74 @@ func (e Errors) Error() string {
 	return strings.Join(errs, ", ")
 }
 
-func decodeError(r io.Reader) error {
+// An APIError is returned when the bunq API responds with an unsuccessful
+// status code. Use errors.As to inspect it.
+type APIError struct {
+	StatusCode int
+	// ResponseID is the value of the `X-Bunq-Client-Response-Id` header. It
+	// identifies the response when contacting bunq support.
+	ResponseID string
+	// RequestID is the value of the `X-Bunq-Client-Request-Id` header of the
+	// request.
+	RequestID string
+	Errors    Errors
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("bunq: request was unsuccessful (status %v", e.StatusCode)
+	if e.ResponseID != "" {
+		msg += ", response ID " + e.ResponseID
+	}
+	msg += ")"
+	if len(e.Errors) > 0 {
+		msg += ": " + e.Errors.Error()
+	}
+
+	return msg
+}
+
+// IsRateLimited reports whether err is an APIError for a request that was
+// rejected because of rate limiting.
+func IsRateLimited(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+// IsUnauthorized reports whether err is an APIError for a request that was
+// rejected because of missing or invalid authentication.
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsNotFound reports whether err is an APIError for a resource that does not
+// exist.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// newAPIError returns an APIError for an unsuccessful response. The response
+// body is read, but not closed.
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		ResponseID: resp.Header.Get("X-Bunq-Client-Response-Id"),
+		RequestID:  resp.Header.Get("X-Bunq-Client-Request-Id"),
+	}
+	if apiErr.RequestID == "" && resp.Request != nil {
+		apiErr.RequestID = resp.Request.Header.Get("X-Bunq-Client-Request-Id")
+	}
+	// The error descriptions are optional; a body that cannot be decoded still
+	// results in an APIError with the status code.
+	apiErr.Errors, _ = decodeError(resp.Body)
+
+	return apiErr
+}
+
+func decodeError(r io.Reader) (Errors, error) {
 	var apiError struct {
 		Error []struct {
 			ErrorDescription           string `json:"error_description"`
@@ -34,7 +103,7 @@ func decodeError(r io.Reader) error {
 	}
 	err := json.NewDecoder(r).Decode(&apiError)
 	if err != nil {
-		return fmt.Errorf("could not decode errors from json: %v", err)
+		return nil, fmt.Errorf("could not decode errors from json: %v", err)
 	}
 
 	var errors Errors
@@ -45,5 +114,5 @@ func decodeError(r io.Reader) error {
 		})
 	}
 
-	return errors
+	return errors, nil
 }
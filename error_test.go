@@ -0,0 +1,76 @@
+package bunq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Bunq-Client-Response-Id", "89dcaa5c-fa55-4068-9822-3f87985d2268")
+		w.Header().Set("X-Bunq-Client-Request-Id", r.Header.Get("X-Bunq-Client-Request-Id"))
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintln(w, `{"Error":[{"error_description":"Resource not found.","error_description_translated":"Resource niet gevonden."}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	_, err := client.GetUser(context.Background(), 42)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got: `%#v`", err)
+	}
+
+	exp := Errors{
+		Error{
+			ErrorDescription:           "Resource not found.",
+			ErrorDescriptionTranslated: "Resource niet gevonden.",
+		},
+	}
+	if eq := reflect.DeepEqual(exp, apiErr.Errors); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, apiErr.Errors)
+	}
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected: `%v`, got: `%v`", http.StatusNotFound, apiErr.StatusCode)
+	}
+	if exp := "89dcaa5c-fa55-4068-9822-3f87985d2268"; apiErr.ResponseID != exp {
+		t.Errorf("Expected: `%v`, got: `%v`", exp, apiErr.ResponseID)
+	}
+	if apiErr.RequestID == "" {
+		t.Error("Expected request ID to be set.")
+	}
+}
+
+func TestAPIErrorClassification(t *testing.T) {
+	tests := []struct {
+		err          error
+		rateLimited  bool
+		unauthorized bool
+		notFound     bool
+	}{
+		{&APIError{StatusCode: http.StatusTooManyRequests}, true, false, false},
+		{&APIError{StatusCode: http.StatusUnauthorized}, false, true, false},
+		{fmt.Errorf("wrapped: %w", &APIError{StatusCode: http.StatusNotFound}), false, false, true},
+		{&APIError{StatusCode: http.StatusBadRequest}, false, false, false},
+		{errors.New("bunq: could not send HTTP request"), false, false, false},
+	}
+
+	for _, tt := range tests {
+		if got := IsRateLimited(tt.err); got != tt.rateLimited {
+			t.Errorf("IsRateLimited(%v): expected: `%v`, got: `%v`", tt.err, tt.rateLimited, got)
+		}
+		if got := IsUnauthorized(tt.err); got != tt.unauthorized {
+			t.Errorf("IsUnauthorized(%v): expected: `%v`, got: `%v`", tt.err, tt.unauthorized, got)
+		}
+		if got := IsNotFound(tt.err); got != tt.notFound {
+			t.Errorf("IsNotFound(%v): expected: `%v`, got: `%v`", tt.err, tt.notFound, got)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"os"
@@ -9,6 +10,7 @@ import (
 )
 
 func main() {
+	ctx := context.Background()
 	client := bunq.NewClient()
 	client.APIKey = os.Getenv("BUNQ_API_KEY")
 	client.BaseURL = "https://sandbox.public.api.bunq.com"
@@ -23,36 +25,19 @@ func main() {
 		os.Exit(1)
 	}
 
-	fmt.Printf("* Creating installation...\n\n")
-	installation, err := client.CreateInstallation()
+	fmt.Printf("* Bootstrapping installation, DeviceServer and Session...\n\n")
+	session, err := client.Bootstrap(ctx, "Foobar", []net.IP{})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
-	fmt.Printf("Created installation: %#v\n", installation)
-
-	fmt.Printf("\n* Creating DeviceServer...\n\n")
-	client.Token = installation.Token.Token
-	deviceServer, err := client.CreateDeviceServer("Foobar", []net.IP{})
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "%v\n", err)
-		os.Exit(1)
-	}
-	fmt.Printf("Created DeviceServer: %#v\n", deviceServer)
+	fmt.Printf("Created Session: %#v\n", session)
 
 	fmt.Printf("\n* Getting DeviceServer...\n\n")
-	deviceServer, err = client.GetDeviceServer(deviceServer.ID)
+	deviceServer, err := client.GetDeviceServer(ctx, client.DeviceServerID)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
 	fmt.Printf("Retrieved DeviceServer: %#v\n", deviceServer)
-
-	fmt.Printf("\n* Creating Session...\n\n")
-	session, err := client.CreateSession()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "%v\n", err)
-		os.Exit(1)
-	}
-	fmt.Printf("Created Session: %#v\n", session)
 }
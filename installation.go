@@ -1,7 +1,7 @@
 package bunq
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -46,8 +46,10 @@ type Installation struct {
 // not found.
 var ErrInstallationNotFound = errors.New("installation not found")
 
-// CreateInstallation creates an installation resource at the bunq API.
-func (c *Client) CreateInstallation() (*Installation, error) {
+// CreateInstallation creates an installation resource at the bunq API. The
+// server public key of the installation is stored on the client and used to
+// verify the signatures of subsequent API responses.
+func (c *Client) CreateInstallation(ctx context.Context) (*Installation, error) {
 	publicKey, err := c.publicKey()
 	if err != nil {
 		return nil, fmt.Errorf("bunq: could not get public key: %v", err)
@@ -61,26 +63,15 @@ func (c *Client) CreateInstallation() (*Installation, error) {
 		return nil, fmt.Errorf("bunq: could not encode request body into JSON: %v", err)
 	}
 
-	endpoint := apiVersion + "/installation"
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%v/%v", c.BaseURL, endpoint), bytes.NewReader(bodyJSON))
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not create new request: %v", err)
-	}
-	setCommonHeaders(req)
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not send HTTP request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("bunq: request was unsuccessful: %v", decodeError(resp.Body))
-	}
-
 	var insResp installationResponse
-	if err = json.NewDecoder(resp.Body).Decode(&insResp); err != nil {
-		return nil, fmt.Errorf("bunq: could not decode HTTP response: %v", err)
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "installation",
+		body:     bodyJSON,
+		unsigned: true,
+	}
+	if err = c.doRequest(ctx, r, &insResp); err != nil {
+		return nil, err
 	}
 
 	if len(insResp.Response) == 0 {
@@ -92,36 +83,20 @@ func (c *Client) CreateInstallation() (*Installation, error) {
 		return nil, fmt.Errorf("bunq: could not parse API response: %v", err)
 	}
 
-	return installation, nil
-}
-
-// GetInstallation gets an Installation resource at the bunq API.
-func (c *Client) GetInstallation(id int) (*Installation, error) {
-	httpMethod := http.MethodGet
-	endpoint := apiVersion + "/installation/" + strconv.Itoa(id)
-	req, err := http.NewRequest(httpMethod, fmt.Sprintf("%v/%v", c.BaseURL, endpoint), nil)
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not create new request: %v", err)
-	}
-	setCommonHeaders(req)
-	req.Header.Set("X-Bunq-Client-Authentication", c.Token)
-	if err = c.addSignature(req, fmt.Sprintf("%v /%v", httpMethod, endpoint), ""); err != nil {
-		return nil, fmt.Errorf("bunq: could not add signature: %v", err)
-	}
-
-	resp, err := c.HTTPClient.Do(req)
+	serverPublicKey, err := parsePublicKey([]byte(installation.ServerPublicKey))
 	if err != nil {
-		return nil, fmt.Errorf("bunq: could not send HTTP request: %v", err)
+		return nil, fmt.Errorf("bunq: could not parse server public key: %v", err)
 	}
-	defer resp.Body.Close()
+	c.ServerPublicKey = serverPublicKey
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("bunq: request was unsuccessful: %v", decodeError(resp.Body))
-	}
+	return installation, nil
+}
 
+// GetInstallation gets an Installation resource at the bunq API.
+func (c *Client) GetInstallation(ctx context.Context, id int) (*Installation, error) {
 	var insResp installationResponse
-	if err = json.NewDecoder(resp.Body).Decode(&insResp); err != nil {
-		return nil, fmt.Errorf("bunq: could not decode HTTP response: %v", err)
+	if err := c.do(ctx, http.MethodGet, "installation/"+strconv.Itoa(id), nil, &insResp); err != nil {
+		return nil, err
 	}
 
 	if len(insResp.Response) == 0 {
@@ -137,45 +112,65 @@ func (c *Client) GetInstallation(id int) (*Installation, error) {
 }
 
 // GetInstallationID gets the installation ID of the Installation used for the
-// current session.
-func (c *Client) GetInstallationID() (int, error) {
-	httpMethod := http.MethodGet
-	endpoint := apiVersion + "/installation"
-	req, err := http.NewRequest(httpMethod, fmt.Sprintf("%v/%v", c.BaseURL, endpoint), nil)
-	if err != nil {
-		return 0, fmt.Errorf("bunq: could not create new request: %v", err)
+// current session. The request is authenticated with the installation token of
+// the client, if set.
+func (c *Client) GetInstallationID(ctx context.Context) (int, error) {
+	var insResp installationResponse
+	if err := c.getInstallation(ctx, "installation", &insResp); err != nil {
+		return 0, err
 	}
-	setCommonHeaders(req)
-	req.Header.Set("X-Bunq-Client-Authentication", c.Token)
-	if err = c.addSignature(req, fmt.Sprintf("%v /%v", httpMethod, endpoint), ""); err != nil {
-		return 0, fmt.Errorf("bunq: could not add signature: %v", err)
+
+	if len(insResp.Response) == 0 {
+		return 0, ErrInstallationNotFound
 	}
 
-	resp, err := c.HTTPClient.Do(req)
+	installation, err := insResp.installation()
 	if err != nil {
-		return 0, fmt.Errorf("bunq: could not send HTTP request: %v", err)
+		return 0, fmt.Errorf("bunq: could not parse API response: %v", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return 0, fmt.Errorf("bunq: request was unsuccessful: %v", decodeError(resp.Body))
-	}
+	return installation.ID, nil
+}
 
+// GetServerPublicKey gets the server public key of an Installation resource at
+// the bunq API, and stores it on the client. The request is authenticated with
+// the installation token of the client, if set.
+func (c *Client) GetServerPublicKey(ctx context.Context, installationID int) (string, error) {
 	var insResp installationResponse
-	if err = json.NewDecoder(resp.Body).Decode(&insResp); err != nil {
-		return 0, fmt.Errorf("bunq: could not decode HTTP response: %v", err)
+	endpoint := "installation/" + strconv.Itoa(installationID) + "/server-public-key"
+	if err := c.getInstallation(ctx, endpoint, &insResp); err != nil {
+		return "", err
 	}
 
-	if len(insResp.Response) == 0 {
-		return 0, ErrInstallationNotFound
+	installation, err := insResp.installation()
+	if err != nil {
+		return "", fmt.Errorf("bunq: could not parse API response: %v", err)
+	}
+	if installation.ServerPublicKey == "" {
+		return "", errors.New("bunq: api response did not contain results")
 	}
 
-	installation, err := insResp.installation()
+	serverPublicKey, err := parsePublicKey([]byte(installation.ServerPublicKey))
 	if err != nil {
-		return 0, fmt.Errorf("bunq: could not parse API response: %v", err)
+		return "", fmt.Errorf("bunq: could not parse server public key: %v", err)
 	}
+	c.ServerPublicKey = serverPublicKey
 
-	return installation.ID, nil
+	return installation.ServerPublicKey, nil
+}
+
+// getInstallation is like doInstallation for a GET request, but accepts the
+// response without verifying it if the client has no server public key yet,
+// because the request is made to get it.
+func (c *Client) getInstallation(ctx context.Context, endpoint string, v interface{}) error {
+	r := &request{
+		method:      http.MethodGet,
+		endpoint:    endpoint,
+		token:       c.InstallationToken,
+		keyExchange: true,
+	}
+
+	return c.doRequest(ctx, r, v)
 }
 
 func (insResp *installationResponse) installation() (*Installation, error) {
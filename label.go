@@ -0,0 +1,32 @@
+package bunq
+
+// The types of an Alias that identify a counterparty.
+const (
+	AliasTypeIBAN        = "IBAN"
+	AliasTypeEmail       = "EMAIL"
+	AliasTypePhoneNumber = "PHONE_NUMBER"
+)
+
+// LabelUser identifies a user at the bunq API without exposing its details.
+type LabelUser struct {
+	UUID           string `json:"uuid"`
+	DisplayName    string `json:"display_name"`
+	Country        string `json:"country"`
+	Avatar         Avatar `json:"avatar"`
+	PublicNickName string `json:"public_nick_name"`
+}
+
+// LabelMonetaryAccount identifies a monetary account, at bunq or another bank,
+// without exposing its details.
+type LabelMonetaryAccount struct {
+	IBAN                 string    `json:"iban"`
+	DisplayName          string    `json:"display_name"`
+	Avatar               Avatar    `json:"avatar"`
+	LabelUser            LabelUser `json:"label_user"`
+	Country              string    `json:"country"`
+	BunqMe               *Alias    `json:"bunq_me,omitempty"`
+	IsLight              bool      `json:"is_light"`
+	SwiftBIC             string    `json:"swift_bic"`
+	SwiftAccountNumber   string    `json:"swift_account_number"`
+	MerchantCategoryCode string    `json:"merchant_category_code"`
+}
@@ -0,0 +1,370 @@
+package bunq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// MonetaryAccountSetting holds the settings of a monetary account.
+type MonetaryAccountSetting struct {
+	Color               string `json:"color,omitempty"`
+	DefaultAvatarStatus string `json:"default_avatar_status,omitempty"`
+	RestrictionChat     string `json:"restriction_chat,omitempty"`
+}
+
+// CoOwner is a co-owner of a joint monetary account.
+type CoOwner struct {
+	Alias  LabelUser `json:"alias"`
+	Status string    `json:"status"`
+}
+
+// CoOwnerInvite invites a user to be a co-owner of a joint monetary account.
+type CoOwnerInvite struct {
+	Alias Alias `json:"alias"`
+}
+
+// MonetaryAccountBank is a regular bank account at the bunq API.
+type MonetaryAccountBank struct {
+	ID                  int                    `json:"id"`
+	CreatedAt           Time                   `json:"created"`
+	UpdatedAt           Time                   `json:"updated"`
+	Avatar              Avatar                 `json:"avatar"`
+	Currency            string                 `json:"currency"`
+	Description         string                 `json:"description"`
+	DailyLimit          Amount                 `json:"daily_limit"`
+	OverdraftLimit      Amount                 `json:"overdraft_limit"`
+	Balance             Amount                 `json:"balance"`
+	Alias               []Alias                `json:"alias"`
+	PublicUUID          string                 `json:"public_uuid"`
+	Status              string                 `json:"status"`
+	SubStatus           string                 `json:"sub_status"`
+	Reason              string                 `json:"reason"`
+	ReasonDescription   string                 `json:"reason_description"`
+	UserID              int                    `json:"user_id"`
+	NotificationFilters []NotificationFilter   `json:"notification_filters"`
+	Setting             MonetaryAccountSetting `json:"setting"`
+}
+
+// MonetaryAccountSavings is a savings account at the bunq API.
+type MonetaryAccountSavings struct {
+	ID                  int                    `json:"id"`
+	CreatedAt           Time                   `json:"created"`
+	UpdatedAt           Time                   `json:"updated"`
+	Avatar              Avatar                 `json:"avatar"`
+	Currency            string                 `json:"currency"`
+	Description         string                 `json:"description"`
+	DailyLimit          Amount                 `json:"daily_limit"`
+	Balance             Amount                 `json:"balance"`
+	Alias               []Alias                `json:"alias"`
+	PublicUUID          string                 `json:"public_uuid"`
+	Status              string                 `json:"status"`
+	SubStatus           string                 `json:"sub_status"`
+	Reason              string                 `json:"reason"`
+	ReasonDescription   string                 `json:"reason_description"`
+	UserID              int                    `json:"user_id"`
+	NotificationFilters []NotificationFilter   `json:"notification_filters"`
+	Setting             MonetaryAccountSetting `json:"setting"`
+	SavingsGoal         Amount                 `json:"savings_goal"`
+	SavingsGoalProgress float64                `json:"savings_goal_progress"`
+}
+
+// MonetaryAccountJoint is a bank account at the bunq API that is owned by
+// multiple users.
+type MonetaryAccountJoint struct {
+	ID                  int                    `json:"id"`
+	CreatedAt           Time                   `json:"created"`
+	UpdatedAt           Time                   `json:"updated"`
+	Avatar              Avatar                 `json:"avatar"`
+	Currency            string                 `json:"currency"`
+	Description         string                 `json:"description"`
+	DailyLimit          Amount                 `json:"daily_limit"`
+	OverdraftLimit      Amount                 `json:"overdraft_limit"`
+	Balance             Amount                 `json:"balance"`
+	Alias               []Alias                `json:"alias"`
+	PublicUUID          string                 `json:"public_uuid"`
+	Status              string                 `json:"status"`
+	SubStatus           string                 `json:"sub_status"`
+	Reason              string                 `json:"reason"`
+	ReasonDescription   string                 `json:"reason_description"`
+	AllCoOwner          []CoOwner              `json:"all_co_owner"`
+	UserID              int                    `json:"user_id"`
+	NotificationFilters []NotificationFilter   `json:"notification_filters"`
+	Setting             MonetaryAccountSetting `json:"setting"`
+}
+
+// MonetaryAccountExternal is a bank account at another bank that is linked to
+// the bunq API.
+type MonetaryAccountExternal struct {
+	ID                  int                    `json:"id"`
+	CreatedAt           Time                   `json:"created"`
+	UpdatedAt           Time                   `json:"updated"`
+	Avatar              Avatar                 `json:"avatar"`
+	Currency            string                 `json:"currency"`
+	Description         string                 `json:"description"`
+	DailyLimit          Amount                 `json:"daily_limit"`
+	Balance             Amount                 `json:"balance"`
+	Alias               []Alias                `json:"alias"`
+	PublicUUID          string                 `json:"public_uuid"`
+	Status              string                 `json:"status"`
+	SubStatus           string                 `json:"sub_status"`
+	Reason              string                 `json:"reason"`
+	ReasonDescription   string                 `json:"reason_description"`
+	UserID              int                    `json:"user_id"`
+	NotificationFilters []NotificationFilter   `json:"notification_filters"`
+	Setting             MonetaryAccountSetting `json:"setting"`
+}
+
+// A MonetaryAccount is a monetary account at the bunq API. It is one of
+// MonetaryAccountBank, MonetaryAccountSavings, MonetaryAccountJoint or
+// MonetaryAccountExternal.
+type MonetaryAccount interface {
+	GetID() int
+	GetDescription() string
+	GetBalance() Amount
+	GetAliases() []Alias
+	GetStatus() string
+}
+
+// GetID returns the ID of the monetary account.
+func (ma MonetaryAccountBank) GetID() int { return ma.ID }
+
+// GetDescription returns the description of the monetary account.
+func (ma MonetaryAccountBank) GetDescription() string { return ma.Description }
+
+// GetBalance returns the balance of the monetary account.
+func (ma MonetaryAccountBank) GetBalance() Amount { return ma.Balance }
+
+// GetAliases returns the aliases, such as the IBAN, of the monetary account.
+func (ma MonetaryAccountBank) GetAliases() []Alias { return ma.Alias }
+
+// GetStatus returns the status of the monetary account.
+func (ma MonetaryAccountBank) GetStatus() string { return ma.Status }
+
+// GetID returns the ID of the monetary account.
+func (ma MonetaryAccountSavings) GetID() int { return ma.ID }
+
+// GetDescription returns the description of the monetary account.
+func (ma MonetaryAccountSavings) GetDescription() string { return ma.Description }
+
+// GetBalance returns the balance of the monetary account.
+func (ma MonetaryAccountSavings) GetBalance() Amount { return ma.Balance }
+
+// GetAliases returns the aliases, such as the IBAN, of the monetary account.
+func (ma MonetaryAccountSavings) GetAliases() []Alias { return ma.Alias }
+
+// GetStatus returns the status of the monetary account.
+func (ma MonetaryAccountSavings) GetStatus() string { return ma.Status }
+
+// GetID returns the ID of the monetary account.
+func (ma MonetaryAccountJoint) GetID() int { return ma.ID }
+
+// GetDescription returns the description of the monetary account.
+func (ma MonetaryAccountJoint) GetDescription() string { return ma.Description }
+
+// GetBalance returns the balance of the monetary account.
+func (ma MonetaryAccountJoint) GetBalance() Amount { return ma.Balance }
+
+// GetAliases returns the aliases, such as the IBAN, of the monetary account.
+func (ma MonetaryAccountJoint) GetAliases() []Alias { return ma.Alias }
+
+// GetStatus returns the status of the monetary account.
+func (ma MonetaryAccountJoint) GetStatus() string { return ma.Status }
+
+// GetID returns the ID of the monetary account.
+func (ma MonetaryAccountExternal) GetID() int { return ma.ID }
+
+// GetDescription returns the description of the monetary account.
+func (ma MonetaryAccountExternal) GetDescription() string { return ma.Description }
+
+// GetBalance returns the balance of the monetary account.
+func (ma MonetaryAccountExternal) GetBalance() Amount { return ma.Balance }
+
+// GetAliases returns the aliases, such as the IBAN, of the monetary account.
+func (ma MonetaryAccountExternal) GetAliases() []Alias { return ma.Alias }
+
+// GetStatus returns the status of the monetary account.
+func (ma MonetaryAccountExternal) GetStatus() string { return ma.Status }
+
+// MonetaryAccountParams are the fields for creating or updating a monetary
+// account. Fields with zero values are not sent.
+type MonetaryAccountParams struct {
+	Currency          string                  `json:"currency,omitempty"`
+	Description       string                  `json:"description,omitempty"`
+	DailyLimit        *Amount                 `json:"daily_limit,omitempty"`
+	AvatarUUID        string                  `json:"avatar_uuid,omitempty"`
+	Status            string                  `json:"status,omitempty"`
+	SubStatus         string                  `json:"sub_status,omitempty"`
+	Reason            string                  `json:"reason,omitempty"`
+	ReasonDescription string                  `json:"reason_description,omitempty"`
+	Setting           *MonetaryAccountSetting `json:"setting,omitempty"`
+	// SavingsGoal is only used for savings accounts.
+	SavingsGoal *Amount `json:"savings_goal,omitempty"`
+	// AllCoOwner is only used for joint accounts.
+	AllCoOwner []CoOwnerInvite `json:"all_co_owner,omitempty"`
+}
+
+type monetaryAccountEnvelope struct {
+	MonetaryAccountBank     *MonetaryAccountBank     `json:"MonetaryAccountBank,omitempty"`
+	MonetaryAccountSavings  *MonetaryAccountSavings  `json:"MonetaryAccountSavings,omitempty"`
+	MonetaryAccountJoint    *MonetaryAccountJoint    `json:"MonetaryAccountJoint,omitempty"`
+	MonetaryAccountExternal *MonetaryAccountExternal `json:"MonetaryAccountExternal,omitempty"`
+}
+
+// monetaryAccount returns the wrapped monetary account, or nil if the envelope
+// contains no monetary account of a known type.
+func (e monetaryAccountEnvelope) monetaryAccount() MonetaryAccount {
+	switch {
+	case e.MonetaryAccountBank != nil:
+		return *e.MonetaryAccountBank
+	case e.MonetaryAccountSavings != nil:
+		return *e.MonetaryAccountSavings
+	case e.MonetaryAccountJoint != nil:
+		return *e.MonetaryAccountJoint
+	case e.MonetaryAccountExternal != nil:
+		return *e.MonetaryAccountExternal
+	}
+
+	return nil
+}
+
+type monetaryAccountResponse struct {
+	Response   []monetaryAccountEnvelope `json:"Response"`
+	Pagination Pagination                `json:"Pagination"`
+}
+
+func (maResp *monetaryAccountResponse) monetaryAccounts() []MonetaryAccount {
+	var monetaryAccounts []MonetaryAccount
+	for i := range maResp.Response {
+		if ma := maResp.Response[i].monetaryAccount(); ma != nil {
+			monetaryAccounts = append(monetaryAccounts, ma)
+		}
+	}
+
+	return monetaryAccounts
+}
+
+// ErrMonetaryAccountNotFound is returned when a single MonetaryAccount
+// resource was not found.
+var ErrMonetaryAccountNotFound = errors.New("monetary account not found")
+
+func monetaryAccountEndpoint(userID int, kind string) string {
+	return "user/" + strconv.Itoa(userID) + "/" + kind
+}
+
+// GetMonetaryAccount gets a MonetaryAccount resource of any type at the bunq
+// API.
+func (c *Client) GetMonetaryAccount(ctx context.Context, userID, id int) (MonetaryAccount, error) {
+	var maResp monetaryAccountResponse
+	endpoint := monetaryAccountEndpoint(userID, "monetary-account") + "/" + strconv.Itoa(id)
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &maResp); err != nil {
+		return nil, err
+	}
+
+	monetaryAccounts := maResp.monetaryAccounts()
+	if len(monetaryAccounts) == 0 {
+		return nil, ErrMonetaryAccountNotFound
+	}
+
+	return monetaryAccounts[0], nil
+}
+
+// ListMonetaryAccounts gets a page of MonetaryAccount resources of any type
+// for a user at the bunq API.
+func (c *Client) ListMonetaryAccounts(ctx context.Context, userID int, opts *ListOptions) ([]MonetaryAccount, error) {
+	monetaryAccounts, _, err := c.listMonetaryAccounts(ctx, opts.endpoint(monetaryAccountEndpoint(userID, "monetary-account")))
+	return monetaryAccounts, err
+}
+
+// IterateMonetaryAccounts returns an iterator over all MonetaryAccount
+// resources of any type for a user at the bunq API.
+func (c *Client) IterateMonetaryAccounts(userID int, opts *ListOptions) *Iterator[MonetaryAccount] {
+	return newIterator(opts.endpoint(monetaryAccountEndpoint(userID, "monetary-account")), c.listMonetaryAccounts)
+}
+
+func (c *Client) listMonetaryAccounts(ctx context.Context, endpoint string) ([]MonetaryAccount, Pagination, error) {
+	var maResp monetaryAccountResponse
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &maResp); err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return maResp.monetaryAccounts(), maResp.Pagination, nil
+}
+
+// CreateMonetaryAccountBank creates a MonetaryAccountBank resource at the bunq
+// API and returns its ID.
+func (c *Client) CreateMonetaryAccountBank(ctx context.Context, userID int, params *MonetaryAccountParams) (int, error) {
+	return c.doID(ctx, http.MethodPost, monetaryAccountEndpoint(userID, "monetary-account-bank"), params)
+}
+
+// UpdateMonetaryAccountBank updates a MonetaryAccountBank resource at the bunq
+// API.
+func (c *Client) UpdateMonetaryAccountBank(ctx context.Context, userID, id int, params *MonetaryAccountParams) error {
+	_, err := c.doID(ctx, http.MethodPut, monetaryAccountEndpoint(userID, "monetary-account-bank")+"/"+strconv.Itoa(id), params)
+	return err
+}
+
+// CreateMonetaryAccountSavings creates a MonetaryAccountSavings resource at
+// the bunq API and returns its ID.
+func (c *Client) CreateMonetaryAccountSavings(ctx context.Context, userID int, params *MonetaryAccountParams) (int, error) {
+	return c.doID(ctx, http.MethodPost, monetaryAccountEndpoint(userID, "monetary-account-savings"), params)
+}
+
+// UpdateMonetaryAccountSavings updates a MonetaryAccountSavings resource at
+// the bunq API.
+func (c *Client) UpdateMonetaryAccountSavings(ctx context.Context, userID, id int, params *MonetaryAccountParams) error {
+	_, err := c.doID(ctx, http.MethodPut, monetaryAccountEndpoint(userID, "monetary-account-savings")+"/"+strconv.Itoa(id), params)
+	return err
+}
+
+// CreateMonetaryAccountJoint creates a MonetaryAccountJoint resource at the
+// bunq API and returns its ID.
+func (c *Client) CreateMonetaryAccountJoint(ctx context.Context, userID int, params *MonetaryAccountParams) (int, error) {
+	return c.doID(ctx, http.MethodPost, monetaryAccountEndpoint(userID, "monetary-account-joint"), params)
+}
+
+// UpdateMonetaryAccountJoint updates a MonetaryAccountJoint resource at the
+// bunq API.
+func (c *Client) UpdateMonetaryAccountJoint(ctx context.Context, userID, id int, params *MonetaryAccountParams) error {
+	_, err := c.doID(ctx, http.MethodPut, monetaryAccountEndpoint(userID, "monetary-account-joint")+"/"+strconv.Itoa(id), params)
+	return err
+}
+
+// CreateMonetaryAccountExternal creates a MonetaryAccountExternal resource at
+// the bunq API and returns its ID.
+func (c *Client) CreateMonetaryAccountExternal(ctx context.Context, userID int, params *MonetaryAccountParams) (int, error) {
+	return c.doID(ctx, http.MethodPost, monetaryAccountEndpoint(userID, "monetary-account-external"), params)
+}
+
+// UpdateMonetaryAccountExternal updates a MonetaryAccountExternal resource at
+// the bunq API.
+func (c *Client) UpdateMonetaryAccountExternal(ctx context.Context, userID, id int, params *MonetaryAccountParams) error {
+	_, err := c.doID(ctx, http.MethodPut, monetaryAccountEndpoint(userID, "monetary-account-external")+"/"+strconv.Itoa(id), params)
+	return err
+}
+
+// CloseMonetaryAccount closes a monetary account of any type at the bunq API.
+// The reason description explains to bunq why the account is closed.
+func (c *Client) CloseMonetaryAccount(ctx context.Context, userID int, ma MonetaryAccount, reasonDescription string) error {
+	params := &MonetaryAccountParams{
+		Status:            "CANCELLED",
+		SubStatus:         "REDEMPTION_VOLUNTARY",
+		Reason:            "OTHER",
+		ReasonDescription: reasonDescription,
+	}
+
+	switch ma.(type) {
+	case MonetaryAccountBank:
+		return c.UpdateMonetaryAccountBank(ctx, userID, ma.GetID(), params)
+	case MonetaryAccountSavings:
+		return c.UpdateMonetaryAccountSavings(ctx, userID, ma.GetID(), params)
+	case MonetaryAccountJoint:
+		return c.UpdateMonetaryAccountJoint(ctx, userID, ma.GetID(), params)
+	case MonetaryAccountExternal:
+		return c.UpdateMonetaryAccountExternal(ctx, userID, ma.GetID(), params)
+	}
+
+	return fmt.Errorf("bunq: unsupported monetary account type %T", ma)
+}
@@ -0,0 +1,105 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListMonetaryAccounts(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "/v1/user/42/monetary-account"; r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.URL.Path)
+		}
+		fmt.Fprintln(w, `{"Response":[{"MonetaryAccountBank":{"id":7,"created":"2015-06-13 23:19:16.215235","updated":"2015-06-30 09:12:31.981573","currency":"EUR","description":"Main","daily_limit":{"value":"1000.00","currency":"EUR"},"balance":{"value":"12.50","currency":"EUR"},"alias":[{"type":"IBAN","value":"NL02BUNQ0123456789","name":"bunq"}],"status":"ACTIVE","user_id":42,"setting":{"color":"#FE2851"}}},{"MonetaryAccountSavings":{"id":8,"description":"Savings","balance":{"value":"100.00","currency":"EUR"},"savings_goal":{"value":"500.00","currency":"EUR"},"savings_goal_progress":0.2,"status":"ACTIVE"}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.ListMonetaryAccounts(context.Background(), 42, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := []MonetaryAccount{
+		MonetaryAccountBank{
+			ID:          7,
+			CreatedAt:   Time(time.Unix(1434237556, 215235000).UTC()),
+			UpdatedAt:   Time(time.Unix(1435655551, 981573000).UTC()),
+			Currency:    "EUR",
+			Description: "Main",
+			DailyLimit:  Amount{Units: 100000, Currency: "EUR"},
+			Balance:     Amount{Units: 1250, Currency: "EUR"},
+			Alias:       []Alias{{Type: "IBAN", Value: "NL02BUNQ0123456789", Name: "bunq"}},
+			Status:      "ACTIVE",
+			UserID:      42,
+			Setting:     MonetaryAccountSetting{Color: "#FE2851"},
+		},
+		MonetaryAccountSavings{
+			ID:                  8,
+			Description:         "Savings",
+			Balance:             Amount{Units: 10000, Currency: "EUR"},
+			SavingsGoal:         Amount{Units: 50000, Currency: "EUR"},
+			SavingsGoalProgress: 0.2,
+			Status:              "ACTIVE",
+		},
+	}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+}
+
+func TestCreateMonetaryAccountBank(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "POST /v1/user/42/monetary-account-bank"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if exp := `{"currency":"EUR","description":"Payroll","daily_limit":{"value":"500.00","currency":"EUR"}}`; string(body) != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, string(body))
+		}
+		fmt.Fprintln(w, `{"Response":[{"Id":{"id":9}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.CreateMonetaryAccountBank(context.Background(), 42, &MonetaryAccountParams{
+		Currency:    "EUR",
+		Description: "Payroll",
+		DailyLimit:  &Amount{Units: 50000, Currency: "EUR"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 9 {
+		t.Errorf("Expected: `%v`, got: `%v`", 9, got)
+	}
+}
+
+func TestCloseMonetaryAccount(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "PUT /v1/user/42/monetary-account-savings/8"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if exp := `{"status":"CANCELLED","sub_status":"REDEMPTION_VOLUNTARY","reason":"OTHER","reason_description":"No longer needed."}`; string(body) != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, string(body))
+		}
+		fmt.Fprintln(w, `{"Response":[{"Id":{"id":8}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	if err := client.CloseMonetaryAccount(context.Background(), 42, MonetaryAccountSavings{ID: 8}, "No longer needed."); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,181 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ListOptions are the options for endpoints that return a list of resources.
+// Resources are listed from newest to oldest.
+type ListOptions struct {
+	// Count is the number of resources per page. The API defaults to 10 and
+	// allows at most 200.
+	Count int
+	// OlderID lists the resources that are older than the resource with this
+	// ID.
+	OlderID int
+	// NewerID lists the resources that are newer than the resource with this
+	// ID.
+	NewerID int
+}
+
+// endpoint returns endpoint with the list options as query parameters.
+func (opts *ListOptions) endpoint(endpoint string) string {
+	if opts == nil {
+		return endpoint
+	}
+	query := url.Values{}
+	if opts.Count > 0 {
+		query.Set("count", strconv.Itoa(opts.Count))
+	}
+	if opts.OlderID > 0 {
+		query.Set("older_id", strconv.Itoa(opts.OlderID))
+	}
+	if opts.NewerID > 0 {
+		query.Set("newer_id", strconv.Itoa(opts.NewerID))
+	}
+	if len(query) == 0 {
+		return endpoint
+	}
+
+	return endpoint + "?" + query.Encode()
+}
+
+// Pagination holds the URLs that list endpoints return for fetching the
+// adjacent pages of a list.
+type Pagination struct {
+	// FutureURL lists the resources created after the newest resource of
+	// the page, once they exist.
+	FutureURL string `json:"future_url"`
+	// NewerURL lists the resources that are newer than the page.
+	NewerURL string `json:"newer_url"`
+	// OlderURL lists the resources that are older than the page.
+	OlderURL string `json:"older_url"`
+}
+
+// pageEndpoint returns the endpoint for a pagination URL, relative to the API
+// version, e.g. "user/42/payment?count=10&older_id=7".
+func pageEndpoint(pageURL string) (string, error) {
+	if pageURL == "" {
+		return "", nil
+	}
+	u, err := url.Parse(pageURL)
+	if err != nil {
+		return "", fmt.Errorf("bunq: could not parse pagination URL: %v", err)
+	}
+	endpoint := strings.TrimPrefix(u.Path, "/")
+	endpoint = strings.TrimPrefix(endpoint, apiVersion+"/")
+	if u.RawQuery != "" {
+		endpoint += "?" + u.RawQuery
+	}
+
+	return endpoint, nil
+}
+
+// pageFunc fetches a single page of resources from endpoint.
+type pageFunc[T any] func(ctx context.Context, endpoint string) ([]T, Pagination, error)
+
+// An Iterator iterates over the resources of a list endpoint, newest first,
+// and fetches pages of older resources as needed. Use Next to advance it:
+//
+//	it := client.IterateUsers(nil)
+//	for it.Next(ctx) {
+//		user := it.Value()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type Iterator[T any] struct {
+	fetch   pageFunc[T]
+	next    string
+	future  string
+	started bool
+	items   []T
+	value   T
+	err     error
+}
+
+func newIterator[T any](endpoint string, fetch pageFunc[T]) *Iterator[T] {
+	return &Iterator[T]{
+		fetch: fetch,
+		next:  endpoint,
+	}
+}
+
+// Next advances the iterator to the next resource, which is then available
+// through Value. It returns false when there are no more resources, or when an
+// error occurred.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	for len(it.items) == 0 {
+		if it.err != nil || it.next == "" {
+			return false
+		}
+		items, pagination, err := it.fetch(ctx, it.next)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		if !it.started {
+			it.started = true
+			if it.future, err = pageEndpoint(pagination.FutureURL); err != nil {
+				it.err = err
+				return false
+			}
+		}
+		if it.next, err = pageEndpoint(pagination.OlderURL); err != nil {
+			it.err = err
+			return false
+		}
+		it.items = items
+	}
+	it.value, it.items = it.items[0], it.items[1:]
+
+	return true
+}
+
+// Value returns the current resource of the iterator.
+func (it *Iterator[T]) Value() T {
+	return it.value
+}
+
+// Err returns the first error that occurred while fetching pages.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Poll returns the resources that were created since the last call to Poll, or
+// since the first page was fetched by Next, newest first. It fetches pages of
+// newer resources until it has caught up. It returns nothing if Next was not
+// called yet.
+func (it *Iterator[T]) Poll(ctx context.Context) ([]T, error) {
+	if it.future == "" {
+		return nil, nil
+	}
+
+	var polled []T
+	endpoint := it.future
+	for {
+		items, pagination, err := it.fetch(ctx, endpoint)
+		if err != nil {
+			return nil, err
+		}
+		polled = append(items, polled...)
+
+		if pagination.NewerURL == "" {
+			if pagination.FutureURL == "" {
+				// Poll from the last page again, to not skip any resources.
+				it.future = endpoint
+			} else if it.future, err = pageEndpoint(pagination.FutureURL); err != nil {
+				return nil, err
+			}
+			return polled, nil
+		}
+		if endpoint, err = pageEndpoint(pagination.NewerURL); err != nil {
+			return nil, err
+		}
+	}
+}
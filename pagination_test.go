@@ -0,0 +1,112 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestIterator(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.RawQuery {
+		case "count=2":
+			fmt.Fprintln(w, `{"Response":[{"UserCompany":{"id":3}},{"UserCompany":{"id":2}}],"Pagination":{"future_url":"/v1/user?count=2&newer_id=3","newer_url":null,"older_url":"/v1/user?count=2&older_id=2"}}`)
+		case "count=2&older_id=2":
+			fmt.Fprintln(w, `{"Response":[{"UserCompany":{"id":1}}],"Pagination":{"future_url":null,"newer_url":"/v1/user?count=2&newer_id=1","older_url":null}}`)
+		case "count=2&newer_id=3":
+			fmt.Fprintln(w, `{"Response":[{"UserCompany":{"id":4}}],"Pagination":{"future_url":"/v1/user?count=2&newer_id=4","newer_url":null,"older_url":null}}`)
+		default:
+			t.Errorf("Unexpected query: `%v`", r.URL.RawQuery)
+		}
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+	ctx := context.Background()
+
+	it := client.IterateUsers(&ListOptions{Count: 2})
+	if got, err := it.Poll(ctx); got != nil || err != nil {
+		t.Errorf("Expected no results before first page, got: `%#v`, `%v`", got, err)
+	}
+
+	var got []int
+	for it.Next(ctx) {
+		got = append(got, it.Value().GetID())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if exp := []int{3, 2, 1}; !reflect.DeepEqual(exp, got) {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+
+	polled, err := it.Poll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(polled) != 1 || polled[0].GetID() != 4 {
+		t.Errorf("Expected user 4, got: `%#v`", polled)
+	}
+}
+
+func TestIteratorPollNewerPages(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.RawQuery {
+		case "count=2":
+			fmt.Fprintln(w, `{"Response":[{"UserCompany":{"id":2}},{"UserCompany":{"id":1}}],"Pagination":{"future_url":"/v1/user?count=2&newer_id=2","newer_url":null,"older_url":null}}`)
+		case "count=2&newer_id=2":
+			fmt.Fprintln(w, `{"Response":[{"UserCompany":{"id":4}},{"UserCompany":{"id":3}}],"Pagination":{"future_url":null,"newer_url":"/v1/user?count=2&newer_id=4","older_url":null}}`)
+		case "count=2&newer_id=4":
+			fmt.Fprintln(w, `{"Response":[{"UserCompany":{"id":5}}],"Pagination":{"future_url":"/v1/user?count=2&newer_id=5","newer_url":null,"older_url":null}}`)
+		case "count=2&newer_id=5":
+			fmt.Fprintln(w, `{"Response":[{"UserCompany":{"id":6}}],"Pagination":{"future_url":"/v1/user?count=2&newer_id=6","newer_url":null,"older_url":null}}`)
+		default:
+			t.Errorf("Unexpected query: `%v`", r.URL.RawQuery)
+		}
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+	ctx := context.Background()
+
+	it := client.IterateUsers(&ListOptions{Count: 2})
+	for it.Next(ctx) {
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, exp := range [][]int{{5, 4, 3}, {6}} {
+		polled, err := it.Poll(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []int
+		for _, user := range polled {
+			got = append(got, user.GetID())
+		}
+		if !reflect.DeepEqual(exp, got) {
+			t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+		}
+	}
+}
+
+func TestListOptions(t *testing.T) {
+	tests := []struct {
+		opts *ListOptions
+		exp  string
+	}{
+		{nil, "user"},
+		{&ListOptions{}, "user"},
+		{&ListOptions{Count: 200, OlderID: 42}, "user?count=200&older_id=42"},
+		{&ListOptions{NewerID: 7}, "user?newer_id=7"},
+	}
+	for _, tt := range tests {
+		if got := tt.opts.endpoint("user"); got != tt.exp {
+			t.Errorf("Expected: `%v`, got: `%v`", tt.exp, got)
+		}
+	}
+}
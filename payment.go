@@ -0,0 +1,134 @@
+package bunq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// PaymentAttachment refers to an attachment of a payment.
+type PaymentAttachment struct {
+	ID                int `json:"id"`
+	MonetaryAccountID int `json:"monetary_account_id,omitempty"`
+}
+
+// A Payment is a payment from or to a monetary account at the bunq API.
+type Payment struct {
+	ID                   int                  `json:"id"`
+	CreatedAt            Time                 `json:"created"`
+	UpdatedAt            Time                 `json:"updated"`
+	MonetaryAccountID    int                  `json:"monetary_account_id"`
+	Amount               Amount               `json:"amount"`
+	Description          string               `json:"description"`
+	Type                 string               `json:"type"`
+	SubType              string               `json:"sub_type"`
+	MerchantReference    string               `json:"merchant_reference"`
+	Alias                LabelMonetaryAccount `json:"alias"`
+	CounterpartyAlias    LabelMonetaryAccount `json:"counterparty_alias"`
+	Attachment           []PaymentAttachment  `json:"attachment"`
+	BunqtoStatus         string               `json:"bunqto_status"`
+	BunqtoSubStatus      string               `json:"bunqto_sub_status"`
+	BunqtoShareURL       string               `json:"bunqto_share_url"`
+	BunqtoExpiry         *Time                `json:"bunqto_expiry"`
+	BunqtoTimeResponded  *Time                `json:"bunqto_time_responded"`
+	BatchID              int                  `json:"batch_id"`
+	ScheduledID          int                  `json:"scheduled_id"`
+	AddressBilling       *Address             `json:"address_billing"`
+	AddressShipping      *Address             `json:"address_shipping"`
+	BalanceAfterMutation Amount               `json:"balance_after_mutation"`
+	// RequestReferences refer to the requests, such as a RequestInquiry, that
+	// were settled by the payment.
+	RequestReferences []RequestReference `json:"request_reference_split_the_bill"`
+}
+
+// A RequestReference refers to a request by its type, such as
+// "RequestInquiry", and ID.
+type RequestReference struct {
+	Type string `json:"type"`
+	ID   int    `json:"id"`
+}
+
+// PaymentParams are the fields for creating a Payment. The counterparty is
+// identified by an alias of type AliasTypeIBAN, AliasTypeEmail or
+// AliasTypePhoneNumber. An IBAN alias requires the name of the counterparty.
+type PaymentParams struct {
+	Amount            Amount              `json:"amount"`
+	CounterpartyAlias Alias               `json:"counterparty_alias"`
+	Description       string              `json:"description"`
+	Attachment        []PaymentAttachment `json:"attachment,omitempty"`
+	MerchantReference string              `json:"merchant_reference,omitempty"`
+	// AllowBunqto allows sending money to an email address or phone number
+	// of someone without a bunq account, who can claim it through bunq.to.
+	AllowBunqto bool `json:"allow_bunqto,omitempty"`
+}
+
+type paymentResponse struct {
+	Response []struct {
+		Payment *Payment `json:"Payment,omitempty"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+func (pr *paymentResponse) payments() []*Payment {
+	var payments []*Payment
+	for i := range pr.Response {
+		if pr.Response[i].Payment != nil {
+			payments = append(payments, pr.Response[i].Payment)
+		}
+	}
+
+	return payments
+}
+
+// ErrPaymentNotFound is returned when a single Payment resource was not found.
+var ErrPaymentNotFound = errors.New("payment not found")
+
+func paymentEndpoint(userID, monetaryAccountID int) string {
+	return fmt.Sprintf("user/%v/monetary-account/%v/payment", userID, monetaryAccountID)
+}
+
+// CreatePayment creates a Payment resource from a monetary account at the bunq
+// API and returns its ID.
+func (c *Client) CreatePayment(ctx context.Context, userID, monetaryAccountID int, params *PaymentParams) (int, error) {
+	return c.doID(ctx, http.MethodPost, paymentEndpoint(userID, monetaryAccountID), params)
+}
+
+// GetPayment gets a Payment resource at the bunq API.
+func (c *Client) GetPayment(ctx context.Context, userID, monetaryAccountID, id int) (*Payment, error) {
+	var pr paymentResponse
+	endpoint := paymentEndpoint(userID, monetaryAccountID) + "/" + strconv.Itoa(id)
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &pr); err != nil {
+		return nil, err
+	}
+
+	payments := pr.payments()
+	if len(payments) == 0 {
+		return nil, ErrPaymentNotFound
+	}
+
+	return payments[0], nil
+}
+
+// ListPayments gets a page of Payment resources of a monetary account at the
+// bunq API.
+func (c *Client) ListPayments(ctx context.Context, userID, monetaryAccountID int, opts *ListOptions) ([]*Payment, error) {
+	payments, _, err := c.listPayments(ctx, opts.endpoint(paymentEndpoint(userID, monetaryAccountID)))
+	return payments, err
+}
+
+// IteratePayments returns an iterator over all Payment resources of a monetary
+// account at the bunq API.
+func (c *Client) IteratePayments(userID, monetaryAccountID int, opts *ListOptions) *Iterator[*Payment] {
+	return newIterator(opts.endpoint(paymentEndpoint(userID, monetaryAccountID)), c.listPayments)
+}
+
+func (c *Client) listPayments(ctx context.Context, endpoint string) ([]*Payment, Pagination, error) {
+	var pr paymentResponse
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &pr); err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return pr.payments(), pr.Pagination, nil
+}
@@ -0,0 +1,217 @@
+package bunq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// MaxPaymentBatchSize is the maximum number of payments in a PaymentBatch.
+const MaxPaymentBatchSize = 200
+
+// A PaymentBatch is a group of payments from a monetary account that are
+// executed at once at the bunq API.
+type PaymentBatch struct {
+	ID       int        `json:"id"`
+	Payments []*Payment `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. The API nests the
+// payments of a batch in a "Payment" object.
+func (pb *PaymentBatch) UnmarshalJSON(data []byte) error {
+	var v struct {
+		ID       int `json:"id"`
+		Payments struct {
+			Payment []*Payment `json:"Payment"`
+		} `json:"payments"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	pb.ID = v.ID
+	pb.Payments = v.Payments.Payment
+
+	return nil
+}
+
+// PaymentBatchParams are the fields for creating a PaymentBatch.
+type PaymentBatchParams struct {
+	Payments []PaymentParams `json:"payments"`
+}
+
+// Total returns the sum of the amounts of the payments in the batch.
+func (p *PaymentBatchParams) Total() (Amount, error) {
+	if len(p.Payments) == 0 {
+		return Amount{}, errors.New("bunq: payment batch has no payments")
+	}
+
+	total := Amount{Currency: p.Payments[0].Amount.Currency}
+	for i := range p.Payments {
+		var err error
+		if total, err = total.Add(p.Payments[i].Amount); err != nil {
+			return Amount{}, fmt.Errorf("bunq: invalid amount of payment %v: %w", i, err)
+		}
+	}
+
+	return total, nil
+}
+
+// Validate checks that the batch has at most MaxPaymentBatchSize payments with
+// positive amounts in a single currency.
+func (p *PaymentBatchParams) Validate() error {
+	if len(p.Payments) > MaxPaymentBatchSize {
+		return fmt.Errorf("bunq: payment batch has %v payments, maximum is %v", len(p.Payments), MaxPaymentBatchSize)
+	}
+	for i := range p.Payments {
+		if p.Payments[i].Amount.Currency == "" {
+			return fmt.Errorf("bunq: payment %v has no currency", i)
+		}
+		if p.Payments[i].Amount.Units <= 0 {
+			return fmt.Errorf("bunq: payment %v has non-positive amount %v", i, p.Payments[i].Amount)
+		}
+	}
+	_, err := p.Total()
+
+	return err
+}
+
+// PaymentBatchItem relates a payment of PaymentBatchParams to the Payment that
+// was created for it. PaymentID is zero if no created payment matched.
+type PaymentBatchItem struct {
+	Params    *PaymentParams
+	PaymentID int
+}
+
+// PaymentBatchResult is the result of creating a PaymentBatch.
+type PaymentBatchResult struct {
+	ID    int
+	Total Amount
+	Items []PaymentBatchItem
+}
+
+type paymentBatchResponse struct {
+	Response []struct {
+		PaymentBatch *PaymentBatch `json:"PaymentBatch,omitempty"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+func (pbr *paymentBatchResponse) paymentBatches() []*PaymentBatch {
+	var batches []*PaymentBatch
+	for i := range pbr.Response {
+		if pbr.Response[i].PaymentBatch != nil {
+			batches = append(batches, pbr.Response[i].PaymentBatch)
+		}
+	}
+
+	return batches
+}
+
+// ErrPaymentBatchNotFound is returned when a single PaymentBatch resource was
+// not found.
+var ErrPaymentBatchNotFound = errors.New("payment batch not found")
+
+func paymentBatchEndpoint(userID, monetaryAccountID int) string {
+	return fmt.Sprintf("user/%v/monetary-account/%v/payment-batch", userID, monetaryAccountID)
+}
+
+// CreatePaymentBatch validates params and creates a PaymentBatch resource from
+// a monetary account at the bunq API. The created batch is fetched to relate
+// each payment of params to its created Payment, by amount, description and
+// IBAN of the counterparty.
+//
+// If the batch was created but could not be fetched, a result with only the
+// ID and total of the batch is returned along with the error. Do not create
+// the batch again in that case, or its payments are made twice.
+func (c *Client) CreatePaymentBatch(ctx context.Context, userID, monetaryAccountID int, params *PaymentBatchParams) (*PaymentBatchResult, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	total, err := params.Total()
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := c.doID(ctx, http.MethodPost, paymentBatchEndpoint(userID, monetaryAccountID), params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PaymentBatchResult{ID: id, Total: total}
+	batch, err := c.GetPaymentBatch(ctx, userID, monetaryAccountID, id)
+	if err != nil {
+		return result, fmt.Errorf("bunq: could not get created payment batch %v: %w", id, err)
+	}
+
+	result.Items = make([]PaymentBatchItem, len(params.Payments))
+	matched := make([]bool, len(batch.Payments))
+	for i := range params.Payments {
+		result.Items[i].Params = &params.Payments[i]
+		for j, payment := range batch.Payments {
+			if !matched[j] && paymentMatches(payment, &params.Payments[i]) {
+				matched[j] = true
+				result.Items[i].PaymentID = payment.ID
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// paymentMatches reports whether payment was created for params. Outgoing
+// payments have negative amounts.
+func paymentMatches(payment *Payment, params *PaymentParams) bool {
+	if payment.Amount != params.Amount && payment.Amount != params.Amount.Neg() {
+		return false
+	}
+	if payment.Description != params.Description {
+		return false
+	}
+	if params.CounterpartyAlias.Type == AliasTypeIBAN && payment.CounterpartyAlias.IBAN != params.CounterpartyAlias.Value {
+		return false
+	}
+
+	return true
+}
+
+// GetPaymentBatch gets a PaymentBatch resource at the bunq API.
+func (c *Client) GetPaymentBatch(ctx context.Context, userID, monetaryAccountID, id int) (*PaymentBatch, error) {
+	var pbr paymentBatchResponse
+	endpoint := paymentBatchEndpoint(userID, monetaryAccountID) + "/" + strconv.Itoa(id)
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &pbr); err != nil {
+		return nil, err
+	}
+
+	batches := pbr.paymentBatches()
+	if len(batches) == 0 {
+		return nil, ErrPaymentBatchNotFound
+	}
+
+	return batches[0], nil
+}
+
+// ListPaymentBatches gets a page of PaymentBatch resources of a monetary
+// account at the bunq API.
+func (c *Client) ListPaymentBatches(ctx context.Context, userID, monetaryAccountID int, opts *ListOptions) ([]*PaymentBatch, error) {
+	batches, _, err := c.listPaymentBatches(ctx, opts.endpoint(paymentBatchEndpoint(userID, monetaryAccountID)))
+	return batches, err
+}
+
+// IteratePaymentBatches returns an iterator over all PaymentBatch resources of
+// a monetary account at the bunq API.
+func (c *Client) IteratePaymentBatches(userID, monetaryAccountID int, opts *ListOptions) *Iterator[*PaymentBatch] {
+	return newIterator(opts.endpoint(paymentBatchEndpoint(userID, monetaryAccountID)), c.listPaymentBatches)
+}
+
+func (c *Client) listPaymentBatches(ctx context.Context, endpoint string) ([]*PaymentBatch, Pagination, error) {
+	var pbr paymentBatchResponse
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &pbr); err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return pbr.paymentBatches(), pbr.Pagination, nil
+}
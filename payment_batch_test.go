@@ -0,0 +1,143 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestCreatePaymentBatch(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v1/user/42/monetary-account/7/payment-batch":
+			body, _ := ioutil.ReadAll(r.Body)
+			if exp := `{"payments":[{"amount":{"value":"12.50","currency":"EUR"},"counterparty_alias":{"type":"IBAN","value":"NL02BUNQ0123456789","name":"Kees"},"description":"Salary"},{"amount":{"value":"20.00","currency":"EUR"},"counterparty_alias":{"type":"IBAN","value":"NL44BUNQ1111111111","name":"Henk"},"description":"Salary"}]}`; string(body) != exp {
+				t.Errorf("Expected: `%v`, got: `%v`", exp, string(body))
+			}
+			fmt.Fprintln(w, `{"Response":[{"Id":{"id":5}}]}`)
+		case "GET /v1/user/42/monetary-account/7/payment-batch/5":
+			fmt.Fprintln(w, `{"Response":[{"PaymentBatch":{"id":5,"payments":{"Payment":[{"id":1338,"amount":{"value":"-20.00","currency":"EUR"},"description":"Salary","counterparty_alias":{"iban":"NL44BUNQ1111111111"}},{"id":1337,"amount":{"value":"-12.50","currency":"EUR"},"description":"Salary","counterparty_alias":{"iban":"NL02BUNQ0123456789"}}]}}}]}`)
+		default:
+			t.Errorf("Unexpected request: `%v %v`", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	params := &PaymentBatchParams{
+		Payments: []PaymentParams{
+			{
+				Amount:            Amount{Units: 1250, Currency: "EUR"},
+				CounterpartyAlias: Alias{Type: AliasTypeIBAN, Value: "NL02BUNQ0123456789", Name: "Kees"},
+				Description:       "Salary",
+			},
+			{
+				Amount:            Amount{Units: 2000, Currency: "EUR"},
+				CounterpartyAlias: Alias{Type: AliasTypeIBAN, Value: "NL44BUNQ1111111111", Name: "Henk"},
+				Description:       "Salary",
+			},
+		},
+	}
+	got, err := client.CreatePaymentBatch(context.Background(), 42, 7, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := &PaymentBatchResult{
+		ID:    5,
+		Total: Amount{Units: 3250, Currency: "EUR"},
+		Items: []PaymentBatchItem{
+			{Params: &params.Payments[0], PaymentID: 1337},
+			{Params: &params.Payments[1], PaymentID: 1338},
+		},
+	}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+}
+
+func TestCreatePaymentBatchGetFailure(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			fmt.Fprintln(w, `{"Response":[{"Id":{"id":5}}]}`)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintln(w, `{"Error":[{"error_description":"Internal server error."}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	params := &PaymentBatchParams{
+		Payments: []PaymentParams{{
+			Amount:            Amount{Units: 1250, Currency: "EUR"},
+			CounterpartyAlias: Alias{Type: AliasTypeIBAN, Value: "NL02BUNQ0123456789", Name: "Kees"},
+			Description:       "Salary",
+		}},
+	}
+	got, err := client.CreatePaymentBatch(context.Background(), 42, 7, params)
+	if !hasStatusCode(err, http.StatusInternalServerError) {
+		t.Errorf("Expected status: `%v`, got: `%v`", http.StatusInternalServerError, err)
+	}
+
+	exp := &PaymentBatchResult{ID: 5, Total: Amount{Units: 1250, Currency: "EUR"}}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+}
+
+func TestPaymentBatchParamsValidate(t *testing.T) {
+	payment := PaymentParams{Amount: Amount{Units: 100, Currency: "EUR"}}
+
+	tests := []struct {
+		name     string
+		payments []PaymentParams
+		valid    bool
+	}{
+		{"valid", []PaymentParams{payment, payment}, true},
+		{"empty", nil, false},
+		{"too large", make([]PaymentParams, MaxPaymentBatchSize+1), false},
+		{"mixed currencies", []PaymentParams{payment, {Amount: Amount{Units: 100, Currency: "USD"}}}, false},
+		{"non-positive amount", []PaymentParams{payment, {Amount: Amount{Units: -100, Currency: "EUR"}}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := &PaymentBatchParams{Payments: tt.payments}
+			if err := params.Validate(); (err == nil) != tt.valid {
+				t.Errorf("Unexpected validation result: %v", err)
+			}
+		})
+	}
+}
+
+func TestListPaymentBatches(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "GET /v1/user/42/monetary-account/7/payment-batch?count=10"; r.Method+" "+r.URL.RequestURI() != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.RequestURI())
+		}
+		fmt.Fprintln(w, `{"Response":[{"PaymentBatch":{"id":5,"payments":{"Payment":[{"id":1337}]}}}],"Pagination":{}}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.ListPaymentBatches(context.Background(), 42, 7, &ListOptions{Count: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := []*PaymentBatch{{ID: 5, Payments: []*Payment{{ID: 1337}}}}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+}
@@ -0,0 +1,89 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreatePayment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "POST /v1/user/42/monetary-account/7/payment"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if exp := `{"amount":{"value":"12.50","currency":"EUR"},"counterparty_alias":{"type":"IBAN","value":"NL02BUNQ0123456789","name":"Kees"},"description":"Invoice 2017-042","attachment":[{"id":13}],"merchant_reference":"2017-042"}`; string(body) != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, string(body))
+		}
+		fmt.Fprintln(w, `{"Response":[{"Id":{"id":1337}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.CreatePayment(context.Background(), 42, 7, &PaymentParams{
+		Amount:            Amount{Units: 1250, Currency: "EUR"},
+		CounterpartyAlias: Alias{Type: AliasTypeIBAN, Value: "NL02BUNQ0123456789", Name: "Kees"},
+		Description:       "Invoice 2017-042",
+		Attachment:        []PaymentAttachment{{ID: 13}},
+		MerchantReference: "2017-042",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 1337 {
+		t.Errorf("Expected: `%v`, got: `%v`", 1337, got)
+	}
+}
+
+func TestGetPayment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "GET /v1/user/42/monetary-account/7/payment/1337"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		fmt.Fprintln(w, `{"Response":[{"Payment":{"id":1337,"created":"2015-06-13 23:19:16.215235","updated":"2015-06-30 09:12:31.981573","monetary_account_id":7,"amount":{"value":"-12.50","currency":"EUR"},"description":"Invoice 2017-042","type":"BUNQ","sub_type":"PAYMENT","merchant_reference":"2017-042","alias":{"iban":"NL65BUNQ9876543210","display_name":"bunq","label_user":{"uuid":"252e-fb1e","display_name":"bunq","country":"NL"},"country":"NL"},"counterparty_alias":{"iban":"NL02BUNQ0123456789","display_name":"Kees","label_user":{"uuid":"f0de-919f","display_name":"Kees","country":"NL"},"country":"NL"},"attachment":[],"bunqto_expiry":null,"balance_after_mutation":{"value":"87.50","currency":"EUR"}}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.GetPayment(context.Background(), 42, 7, 1337)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := &Payment{
+		ID:                1337,
+		CreatedAt:         Time(time.Unix(1434237556, 215235000).UTC()),
+		UpdatedAt:         Time(time.Unix(1435655551, 981573000).UTC()),
+		MonetaryAccountID: 7,
+		Amount:            Amount{Units: -1250, Currency: "EUR"},
+		Description:       "Invoice 2017-042",
+		Type:              "BUNQ",
+		SubType:           "PAYMENT",
+		MerchantReference: "2017-042",
+		Alias: LabelMonetaryAccount{
+			IBAN:        "NL65BUNQ9876543210",
+			DisplayName: "bunq",
+			LabelUser:   LabelUser{UUID: "252e-fb1e", DisplayName: "bunq", Country: "NL"},
+			Country:     "NL",
+		},
+		CounterpartyAlias: LabelMonetaryAccount{
+			IBAN:        "NL02BUNQ0123456789",
+			DisplayName: "Kees",
+			LabelUser:   LabelUser{UUID: "f0de-919f", DisplayName: "Kees", Country: "NL"},
+			Country:     "NL",
+		},
+		Attachment:           []PaymentAttachment{},
+		BalanceAfterMutation: Amount{Units: 8750, Currency: "EUR"},
+	}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+}
@@ -0,0 +1,213 @@
+package bunq
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A Rate is a number of requests that is allowed per period.
+type Rate struct {
+	Requests int
+	Per      time.Duration
+}
+
+// A RateLimiter delays requests to stay within the rate limits of the bunq API.
+// Limits apply per HTTP method and endpoint family, such as
+// `GET user/{id}/monetary-account`, and are enforced with token buckets. A
+// RateLimiter is safe for concurrent use.
+type RateLimiter struct {
+	mu      sync.Mutex
+	rates   map[string]Rate
+	buckets map[string]*bucket
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a RateLimiter with the rate limits of the bunq API: 3
+// GET, 5 POST and 2 PUT or DELETE requests per 3 seconds, and 1 POST request
+// per 30 seconds for session-server.
+func NewRateLimiter() *RateLimiter {
+	l := &RateLimiter{
+		rates:   make(map[string]Rate),
+		buckets: make(map[string]*bucket),
+	}
+	l.SetRate(http.MethodGet, "", Rate{3, 3 * time.Second})
+	l.SetRate(http.MethodPost, "", Rate{5, 3 * time.Second})
+	l.SetRate(http.MethodPut, "", Rate{2, 3 * time.Second})
+	l.SetRate(http.MethodDelete, "", Rate{2, 3 * time.Second})
+	l.SetRate(http.MethodPost, "session-server", Rate{1, 30 * time.Second})
+
+	return l
+}
+
+// SetRate sets the rate limit for requests with method. If endpoint is not
+// empty, the limit only applies to its endpoint family, e.g.
+// "user/{id}/payment" or "session-server".
+func (l *RateLimiter) SetRate(method, endpoint string, rate Rate) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rates[method+" "+endpoint] = rate
+}
+
+// Wait blocks until a request with method to endpoint is allowed, or until ctx
+// is done. Requests for methods without a rate limit are allowed immediately.
+func (l *RateLimiter) Wait(ctx context.Context, method, endpoint string) error {
+	key := method + " " + endpointFamily(endpoint)
+
+	l.mu.Lock()
+	rate, ok := l.rates[key]
+	if !ok {
+		rate, ok = l.rates[method+" "]
+	}
+	if !ok || rate.Requests <= 0 || rate.Per <= 0 {
+		l.mu.Unlock()
+		return nil
+	}
+
+	now := time.Now()
+	perToken := rate.Per / time.Duration(rate.Requests)
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(rate.Requests), last: now}
+		l.buckets[key] = b
+	}
+	b.tokens += float64(now.Sub(b.last)) / float64(perToken)
+	if b.tokens > float64(rate.Requests) {
+		b.tokens = float64(rate.Requests)
+	}
+	b.last = now
+	// Reserve a token; a negative balance is the wait for this request.
+	b.tokens--
+	delay := time.Duration(-b.tokens * float64(perToken))
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	if err := sleep(ctx, delay); err != nil {
+		l.mu.Lock()
+		b.tokens++
+		l.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// endpointFamily returns endpoint without query and with IDs replaced by
+// `{id}`, e.g. "user/{id}/monetary-account".
+func endpointFamily(endpoint string) string {
+	if i := strings.IndexByte(endpoint, '?'); i >= 0 {
+		endpoint = endpoint[:i]
+	}
+	segments := strings.Split(endpoint, "/")
+	for i := range segments {
+		if _, err := strconv.Atoi(segments[i]); err == nil {
+			segments[i] = "{id}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// A RetryPolicy configures retries of idempotent requests (GET, PUT and
+// DELETE) that failed with status code 429 or 5xx. Retries are delayed with
+// jittered exponential backoff, or by the Retry-After header of the response
+// if that is longer, up to MaxBackoff.
+type RetryPolicy struct {
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is a RetryPolicy suitable for the rate limits of the bunq
+// API.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinBackoff: 1 * time.Second,
+	MaxBackoff: 30 * time.Second,
+}
+
+func (p *RetryPolicy) retry(r *request, resp *http.Response, attempt int) bool {
+	if p == nil || attempt >= p.MaxRetries {
+		return false
+	}
+	switch r.method {
+	case http.MethodGet, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// delay returns the delay before retrying after resp. It is the backoff for
+// attempt, but at least the duration of a Retry-After header of resp, capped
+// at MaxBackoff.
+func (p *RetryPolicy) delay(resp *http.Response, attempt int) time.Duration {
+	d := p.backoff(attempt)
+	retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	if retryAfter > p.MaxBackoff {
+		retryAfter = p.MaxBackoff
+	}
+	if retryAfter > d {
+		d = retryAfter
+	}
+
+	return d
+}
+
+// parseRetryAfter parses the value of a Retry-After header, either in seconds
+// or as HTTP date, into a duration from now.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+
+	return 0
+}
+
+// backoff returns a random delay between half and all of the exponential
+// backoff for attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MinBackoff << uint(attempt)
+	if d > p.MaxBackoff || d <= 0 {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// sleep pauses for d, or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
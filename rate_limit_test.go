@@ -0,0 +1,177 @@
+package bunq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestEndpointFamily(t *testing.T) {
+	tests := map[string]string{
+		"user": "user",
+		"user/42/monetary-account/7/payment?count=10": "user/{id}/monetary-account/{id}/payment",
+		"session-server": "session-server",
+	}
+	for endpoint, exp := range tests {
+		if got := endpointFamily(endpoint); got != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, got)
+		}
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	l := NewRateLimiter()
+	l.SetRate(http.MethodGet, "", Rate{2, 100 * time.Millisecond})
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(ctx, http.MethodGet, "user/"+fmt.Sprint(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("Expected third request to be delayed, got: `%v`", elapsed)
+	}
+
+	// Other endpoint families have their own bucket.
+	start = time.Now()
+	if err := l.Wait(ctx, http.MethodGet, "device"); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > 20*time.Millisecond {
+		t.Errorf("Expected request not to be delayed, got: `%v`", elapsed)
+	}
+
+	// Session-server has a stricter limit than other POST requests.
+	if err := l.Wait(ctx, http.MethodPost, "session-server"); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx, http.MethodPost, "session-server"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected: `%v`, got: `%v`", context.DeadlineExceeded, err)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name      string
+		call      func(c *Client) error
+		expCalls  int
+		expStatus int
+	}{
+		{
+			name: "idempotent request",
+			call: func(c *Client) error {
+				_, err := c.ListUsers(context.Background(), nil)
+				return err
+			},
+			expCalls: 3,
+		},
+		{
+			name: "non-idempotent request",
+			call: func(c *Client) error {
+				_, err := c.CreateSession(context.Background())
+				return err
+			},
+			expCalls:  1,
+			expStatus: http.StatusTooManyRequests,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				switch calls {
+				case 1:
+					w.WriteHeader(http.StatusTooManyRequests)
+					fmt.Fprintln(w, `{"Error":[{"error_description":"Too many requests. You can do a maximum of 3 calls per 3 second to this endpoint."}]}`)
+				case 2:
+					w.WriteHeader(http.StatusServiceUnavailable)
+					fmt.Fprintln(w, `{"Error":[]}`)
+				default:
+					fmt.Fprintln(w, `{"Response":[]}`)
+				}
+			}))
+			defer ts.Close()
+
+			client := newTestClient(t, ts.URL)
+			client.Retry = &RetryPolicy{
+				MaxRetries: 3,
+				MinBackoff: time.Millisecond,
+				MaxBackoff: 5 * time.Millisecond,
+			}
+
+			err := tt.call(client)
+			if tt.expStatus == 0 && err != nil {
+				t.Fatal(err)
+			}
+			if tt.expStatus != 0 && !hasStatusCode(err, tt.expStatus) {
+				t.Errorf("Expected status: `%v`, got: `%v`", tt.expStatus, err)
+			}
+			if calls != tt.expCalls {
+				t.Errorf("Expected: `%v` calls, got: `%v`", tt.expCalls, calls)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2017, 3, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		exp   time.Duration
+	}{
+		{"", 0},
+		{"2", 2 * time.Second},
+		{"-1", 0},
+		{"Wed, 01 Mar 2017 10:00:05 GMT", 5 * time.Second},
+		{"Wed, 01 Mar 2017 09:59:00 GMT", 0},
+		{"soon", 0},
+	}
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value, now); got != tt.exp {
+			t.Errorf("Expected: `%v`, got: `%v`", tt.exp, got)
+		}
+	}
+
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "3")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprintln(w, `{"Error":[]}`)
+			return
+		}
+		fmt.Fprintln(w, `{"Response":[]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+	client.Retry = &RetryPolicy{
+		MaxRetries: 1,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 50 * time.Millisecond,
+	}
+
+	start := time.Now()
+	if _, err := client.ListUsers(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	// The Retry-After of 3 seconds is capped at MaxBackoff, which is still
+	// longer than the backoff of at most a millisecond.
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond || elapsed > 2*time.Second {
+		t.Errorf("Expected retry after `%v`, got: `%v`", 50*time.Millisecond, elapsed)
+	}
+	if calls != 2 {
+		t.Errorf("Expected: `%v` calls, got: `%v`", 2, calls)
+	}
+}
@@ -0,0 +1,308 @@
+package bunq
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// A Doer sends an HTTP request and returns an HTTP response. It is implemented
+// by *http.Client.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc is an adapter to allow the use of ordinary functions as a Doer.
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// A Middleware wraps a Doer to add behavior, such as logging or metrics, to
+// every HTTP request sent by a Client.
+type Middleware func(next Doer) Doer
+
+// request describes a single call to an endpoint of the bunq API.
+type request struct {
+	method string
+	// endpoint is relative to the API version, e.g. "device-server/42".
+	endpoint string
+	header   http.Header
+	body     []byte
+	// token is used for authentication instead of the token of the client,
+	// if set.
+	token string
+	// unsigned requests are sent without authentication token and signature.
+	// Their responses are not verified.
+	unsigned bool
+	// encrypted requests have their body encrypted with the server public
+	// key, as required by the API for sensitive endpoints. Other requests
+	// are encrypted if their context was made with WithBodyEncryption.
+	encrypted bool
+	// stream requests have the signature of their response verified while
+	// the response body is read, instead of before it is returned.
+	stream bool
+	// keyExchange requests are made to get the server public key, so their
+	// responses are only verified if the client already has it.
+	keyExchange bool
+}
+
+// do sends an API request with body encoded as JSON, and decodes the JSON
+// response into v. Both body and v may be nil.
+func (c *Client) do(ctx context.Context, method, endpoint string, body, v interface{}) error {
+	r, err := newJSONRequest(method, endpoint, body)
+	if err != nil {
+		return err
+	}
+
+	return c.doRequest(ctx, r, v)
+}
+
+// doInstallation is like do, but authenticates the request with the
+// installation token of the client, if set. It is used for the endpoints that
+// are called before a session exists.
+func (c *Client) doInstallation(ctx context.Context, method, endpoint string, body, v interface{}) error {
+	r, err := newJSONRequest(method, endpoint, body)
+	if err != nil {
+		return err
+	}
+	r.token = c.InstallationToken
+
+	return c.doRequest(ctx, r, v)
+}
+
+// doEncrypted is like do, but encrypts the request body.
+func (c *Client) doEncrypted(ctx context.Context, method, endpoint string, body, v interface{}) error {
+	r, err := newJSONRequest(method, endpoint, body)
+	if err != nil {
+		return err
+	}
+	r.encrypted = true
+
+	return c.doRequest(ctx, r, v)
+}
+
+func newJSONRequest(method, endpoint string, body interface{}) (*request, error) {
+	r := &request{
+		method:   method,
+		endpoint: endpoint,
+	}
+	if body != nil {
+		bodyJSON, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("bunq: could not encode request body into JSON: %v", err)
+		}
+		r.body = bodyJSON
+	}
+
+	return r, nil
+}
+
+// doRequest sends an API request and decodes the JSON response into v, if v is
+// not nil.
+func (c *Client) doRequest(ctx context.Context, r *request, v interface{}) error {
+	resp, err := c.send(ctx, r)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if v == nil {
+		return nil
+	}
+	if err = json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("bunq: could not decode HTTP response: %v", err)
+	}
+
+	return nil
+}
+
+// send sends an API request through the middleware of the client, and returns
+// the response after verifying its signature, or with a body that verifies it
+// while being read for stream requests. An *APIError is returned for
+// unsuccessful responses, whose signature is not verified, because they may
+// come from a proxy instead of the API. The caller must close the response
+// body.
+//
+// Requests that are authenticated with the session token of a client that has
+// an installation token get their session renewed shortly before it expires,
+// or once after the API rejected the session token.
+func (c *Client) send(ctx context.Context, r *request) (*http.Response, error) {
+	// Requests that set up the installation or session, such as a session
+	// renewal in the context of an encrypted request, are never encrypted.
+	if !r.encrypted && !r.unsigned && r.token == "" && len(r.body) > 0 && bodyEncryption(ctx) {
+		encrypted := *r
+		encrypted.encrypted = true
+		r = &encrypted
+	}
+
+	token, expiry := c.session()
+	if r.token != "" {
+		token = r.token
+	}
+	renewable := !r.unsigned && r.token == "" && c.InstallationToken != ""
+
+	if renewable && !expiry.IsZero() && time.Until(expiry) < sessionRenewalMargin {
+		if err := c.renewSession(ctx, token); err != nil {
+			return nil, err
+		}
+		token, _ = c.session()
+	}
+
+	resp, err := c.retryRoundTrip(ctx, r, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if renewable && resp.StatusCode == http.StatusUnauthorized {
+		resp.Body.Close()
+		if err = c.renewSession(ctx, token); err != nil {
+			return nil, err
+		}
+		token, _ = c.session()
+		if resp, err = c.retryRoundTrip(ctx, r, token); err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, newAPIError(resp)
+	}
+
+	switch {
+	case r.unsigned, c.InsecureSkipVerify, r.keyExchange && c.ServerPublicKey == nil:
+	case c.ServerPublicKey == nil:
+		err = ErrMissingServerPublicKey
+	case r.stream:
+		err = c.streamResponse(resp)
+	default:
+		err = c.verifyResponse(resp)
+	}
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// retryRoundTrip calls roundTrip after waiting for the rate limiter of the
+// client, and retries the request according to the retry policy of the client.
+func (c *Client) retryRoundTrip(ctx context.Context, r *request, token string) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if c.RateLimiter != nil {
+			if err := c.RateLimiter.Wait(ctx, r.method, r.endpoint); err != nil {
+				return nil, fmt.Errorf("bunq: could not wait for rate limiter: %w", err)
+			}
+		}
+
+		resp, err := c.roundTrip(ctx, r, token)
+		if err != nil || !c.Retry.retry(r, resp, attempt) {
+			return resp, err
+		}
+		resp.Body.Close()
+
+		if err = sleep(ctx, c.Retry.delay(resp, attempt)); err != nil {
+			return nil, fmt.Errorf("bunq: could not retry request: %w", err)
+		}
+	}
+}
+
+// roundTrip sends a single HTTP request for an API request, authenticated with
+// token.
+func (c *Client) roundTrip(ctx context.Context, r *request, token string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, r, token)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doer().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("bunq: could not send HTTP request: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) newRequest(ctx context.Context, r *request, token string) (*http.Request, error) {
+	body := r.body
+	var encryptionHeader http.Header
+	if r.encrypted {
+		var err error
+		if body, encryptionHeader, err = c.encryptBody(rand.Reader, r.body); err != nil {
+			return nil, err
+		}
+	}
+
+	endpoint := apiVersion + "/" + r.endpoint
+	req, err := http.NewRequestWithContext(ctx, r.method, fmt.Sprintf("%v/%v", c.BaseURL, endpoint), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("bunq: could not create new request: %v", err)
+	}
+	setCommonHeaders(req)
+	for key, val := range r.header {
+		req.Header[key] = val
+	}
+	for key, val := range encryptionHeader {
+		req.Header[key] = val
+	}
+	if r.unsigned {
+		return req, nil
+	}
+
+	req.Header.Set("X-Bunq-Client-Authentication", token)
+	if err = c.addSignature(req, fmt.Sprintf("%v /%v", r.method, endpoint), string(body)); err != nil {
+		return nil, fmt.Errorf("bunq: could not add signature: %v", err)
+	}
+
+	return req, nil
+}
+
+// doer returns the HTTP client of c wrapped in its middleware. The first
+// middleware is the outermost.
+func (c *Client) doer() Doer {
+	var d Doer = c.HTTPClient
+	for i := len(c.Middleware) - 1; i >= 0; i-- {
+		d = c.Middleware[i](d)
+	}
+
+	return d
+}
+
+// idResponse is the response of endpoints that create or update a resource.
+type idResponse struct {
+	Response []struct {
+		ID *struct {
+			ID int `json:"id"`
+		} `json:"Id,omitempty"`
+	} `json:"Response"`
+}
+
+// doID is like do, but returns the resource ID from the response of an
+// endpoint that creates or updates a resource.
+func (c *Client) doID(ctx context.Context, method, endpoint string, body interface{}) (int, error) {
+	var idResp idResponse
+	if err := c.do(ctx, method, endpoint, body, &idResp); err != nil {
+		return 0, err
+	}
+
+	return idResp.id()
+}
+
+func (idResp *idResponse) id() (int, error) {
+	for i := range idResp.Response {
+		if idResp.Response[i].ID != nil {
+			return idResp.Response[i].ID.ID, nil
+		}
+	}
+
+	return 0, errors.New("bunq: api response did not contain results")
+}
@@ -0,0 +1,295 @@
+package bunq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RequestStatus is the status of a payment request.
+type RequestStatus string
+
+// The statuses of a RequestInquiry or RequestResponse.
+const (
+	RequestStatusPending  RequestStatus = "PENDING"
+	RequestStatusAccepted RequestStatus = "ACCEPTED"
+	RequestStatusRejected RequestStatus = "REJECTED"
+	RequestStatusRevoked  RequestStatus = "REVOKED"
+	RequestStatusExpired  RequestStatus = "EXPIRED"
+)
+
+// The addresses a counterparty must provide when responding to a request.
+const (
+	RequireAddressNone            = "NONE"
+	RequireAddressBilling         = "BILLING"
+	RequireAddressShipping        = "SHIPPING"
+	RequireAddressBillingShipping = "BILLING_SHIPPING"
+	RequireAddressOptional        = "OPTIONAL"
+)
+
+// A RequestInquiry is a request for payment sent from a monetary account to a
+// counterparty.
+type RequestInquiry struct {
+	ID                int                  `json:"id"`
+	CreatedAt         Time                 `json:"created"`
+	UpdatedAt         Time                 `json:"updated"`
+	TimeResponded     *Time                `json:"time_responded"`
+	TimeExpiry        *Time                `json:"time_expiry"`
+	MonetaryAccountID int                  `json:"monetary_account_id"`
+	AmountInquired    Amount               `json:"amount_inquired"`
+	AmountResponded   *Amount              `json:"amount_responded"`
+	UserAliasCreated  LabelUser            `json:"user_alias_created"`
+	UserAliasRevoked  *LabelUser           `json:"user_alias_revoked"`
+	CounterpartyAlias LabelMonetaryAccount `json:"counterparty_alias"`
+	Description       string               `json:"description"`
+	MerchantReference string               `json:"merchant_reference"`
+	Attachment        []PaymentAttachment  `json:"attachment"`
+	Status            RequestStatus        `json:"status"`
+	BatchID           int                  `json:"batch_id"`
+	ScheduledID       int                  `json:"scheduled_id"`
+	MinimumAge        int                  `json:"minimum_age"`
+	RequireAddress    string               `json:"require_address"`
+	BunqmeShareURL    string               `json:"bunqme_share_url"`
+	RedirectURL       string               `json:"redirect_url"`
+	AddressBilling    *Address             `json:"address_billing"`
+	AddressShipping   *Address             `json:"address_shipping"`
+}
+
+// RequestInquiryParams are the fields for creating a RequestInquiry.
+type RequestInquiryParams struct {
+	AmountInquired    Amount              `json:"amount_inquired"`
+	CounterpartyAlias Alias               `json:"counterparty_alias"`
+	Description       string              `json:"description"`
+	Attachment        []PaymentAttachment `json:"attachment,omitempty"`
+	MerchantReference string              `json:"merchant_reference,omitempty"`
+	// MinimumAge is the minimum age, from 12 to 100, the counterparty must
+	// have to respond to the request.
+	MinimumAge     int    `json:"minimum_age,omitempty"`
+	RequireAddress string `json:"require_address,omitempty"`
+	// AllowBunqme allows the counterparty to pay the request through bunq.me
+	// when they do not have a bunq account.
+	AllowBunqme bool `json:"allow_bunqme"`
+	// RedirectURL is the URL the counterparty is sent to after paying the
+	// request through bunq.me.
+	RedirectURL string `json:"redirect_url,omitempty"`
+}
+
+type requestInquiryResponse struct {
+	Response []struct {
+		RequestInquiry *RequestInquiry `json:"RequestInquiry,omitempty"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+func (rir *requestInquiryResponse) requestInquiries() []*RequestInquiry {
+	var requestInquiries []*RequestInquiry
+	for i := range rir.Response {
+		if rir.Response[i].RequestInquiry != nil {
+			requestInquiries = append(requestInquiries, rir.Response[i].RequestInquiry)
+		}
+	}
+
+	return requestInquiries
+}
+
+// ErrRequestInquiryNotFound is returned when a single RequestInquiry resource
+// was not found.
+var ErrRequestInquiryNotFound = errors.New("request inquiry not found")
+
+func requestInquiryEndpoint(userID, monetaryAccountID int) string {
+	return fmt.Sprintf("user/%v/monetary-account/%v/request-inquiry", userID, monetaryAccountID)
+}
+
+// CreateRequestInquiry creates a RequestInquiry resource from a monetary
+// account at the bunq API and returns its ID.
+func (c *Client) CreateRequestInquiry(ctx context.Context, userID, monetaryAccountID int, params *RequestInquiryParams) (int, error) {
+	return c.doID(ctx, http.MethodPost, requestInquiryEndpoint(userID, monetaryAccountID), params)
+}
+
+// RevokeRequestInquiry revokes a pending RequestInquiry resource at the bunq
+// API.
+func (c *Client) RevokeRequestInquiry(ctx context.Context, userID, monetaryAccountID, id int) error {
+	body := struct {
+		Status RequestStatus `json:"status"`
+	}{RequestStatusRevoked}
+	_, err := c.doID(ctx, http.MethodPut, requestInquiryEndpoint(userID, monetaryAccountID)+"/"+strconv.Itoa(id), body)
+	return err
+}
+
+// GetRequestInquiry gets a RequestInquiry resource at the bunq API.
+func (c *Client) GetRequestInquiry(ctx context.Context, userID, monetaryAccountID, id int) (*RequestInquiry, error) {
+	var rir requestInquiryResponse
+	endpoint := requestInquiryEndpoint(userID, monetaryAccountID) + "/" + strconv.Itoa(id)
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &rir); err != nil {
+		return nil, err
+	}
+
+	requestInquiries := rir.requestInquiries()
+	if len(requestInquiries) == 0 {
+		return nil, ErrRequestInquiryNotFound
+	}
+
+	return requestInquiries[0], nil
+}
+
+// ListRequestInquiries gets a page of RequestInquiry resources of a monetary
+// account at the bunq API.
+func (c *Client) ListRequestInquiries(ctx context.Context, userID, monetaryAccountID int, opts *ListOptions) ([]*RequestInquiry, error) {
+	requestInquiries, _, err := c.listRequestInquiries(ctx, opts.endpoint(requestInquiryEndpoint(userID, monetaryAccountID)))
+	return requestInquiries, err
+}
+
+// IterateRequestInquiries returns an iterator over all RequestInquiry
+// resources of a monetary account at the bunq API.
+func (c *Client) IterateRequestInquiries(userID, monetaryAccountID int, opts *ListOptions) *Iterator[*RequestInquiry] {
+	return newIterator(opts.endpoint(requestInquiryEndpoint(userID, monetaryAccountID)), c.listRequestInquiries)
+}
+
+func (c *Client) listRequestInquiries(ctx context.Context, endpoint string) ([]*RequestInquiry, Pagination, error) {
+	var rir requestInquiryResponse
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &rir); err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return rir.requestInquiries(), rir.Pagination, nil
+}
+
+// GetRequestInquiryPayment gets the Payment that settled an accepted
+// RequestInquiry, by searching the payments of its monetary account that were
+// made after the request was created. ErrPaymentNotFound is returned if the
+// request was not settled.
+func (c *Client) GetRequestInquiryPayment(ctx context.Context, userID int, requestInquiry *RequestInquiry) (*Payment, error) {
+	if requestInquiry.Status != RequestStatusAccepted {
+		return nil, ErrPaymentNotFound
+	}
+
+	created := time.Time(requestInquiry.CreatedAt)
+	it := c.IteratePayments(userID, requestInquiry.MonetaryAccountID, &ListOptions{Count: 200})
+	for it.Next(ctx) {
+		payment := it.Value()
+		if time.Time(payment.CreatedAt).Before(created) {
+			break
+		}
+		for _, ref := range payment.RequestReferences {
+			if ref.Type == "RequestInquiry" && ref.ID == requestInquiry.ID {
+				return payment, nil
+			}
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, ErrPaymentNotFound
+}
+
+// A RequestInquiryBatch is a group of requests for payment sent at once from
+// a monetary account.
+type RequestInquiryBatch struct {
+	ID                  int               `json:"id"`
+	RequestInquiries    []*RequestInquiry `json:"request_inquiries"`
+	TotalAmountInquired Amount            `json:"total_amount_inquired"`
+}
+
+// RequestInquiryBatchParams are the fields for creating a
+// RequestInquiryBatch.
+type RequestInquiryBatchParams struct {
+	RequestInquiries    []RequestInquiryParams `json:"request_inquiries"`
+	TotalAmountInquired Amount                 `json:"total_amount_inquired"`
+}
+
+type requestInquiryBatchResponse struct {
+	Response []struct {
+		RequestInquiryBatch *RequestInquiryBatch `json:"RequestInquiryBatch,omitempty"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+func (rirb *requestInquiryBatchResponse) requestInquiryBatches() []*RequestInquiryBatch {
+	var batches []*RequestInquiryBatch
+	for i := range rirb.Response {
+		if rirb.Response[i].RequestInquiryBatch != nil {
+			batches = append(batches, rirb.Response[i].RequestInquiryBatch)
+		}
+	}
+
+	return batches
+}
+
+// ErrRequestInquiryBatchNotFound is returned when a single RequestInquiryBatch
+// resource was not found.
+var ErrRequestInquiryBatchNotFound = errors.New("request inquiry batch not found")
+
+func requestInquiryBatchEndpoint(userID, monetaryAccountID int) string {
+	return fmt.Sprintf("user/%v/monetary-account/%v/request-inquiry-batch", userID, monetaryAccountID)
+}
+
+// CreateRequestInquiryBatch creates a RequestInquiryBatch resource from a
+// monetary account at the bunq API and returns its ID. The total amount is
+// calculated from the requests if it is zero. Params are not modified.
+func (c *Client) CreateRequestInquiryBatch(ctx context.Context, userID, monetaryAccountID int, params *RequestInquiryBatchParams) (int, error) {
+	body := *params
+	if body.TotalAmountInquired.IsZero() && len(body.RequestInquiries) > 0 {
+		total := Amount{Currency: body.RequestInquiries[0].AmountInquired.Currency}
+		for i := range body.RequestInquiries {
+			var err error
+			if total, err = total.Add(body.RequestInquiries[i].AmountInquired); err != nil {
+				return 0, fmt.Errorf("bunq: invalid amount of request inquiry %v: %w", i, err)
+			}
+		}
+		body.TotalAmountInquired = total
+	}
+
+	return c.doID(ctx, http.MethodPost, requestInquiryBatchEndpoint(userID, monetaryAccountID), &body)
+}
+
+// RevokeRequestInquiryBatch revokes the pending requests of a
+// RequestInquiryBatch resource at the bunq API.
+func (c *Client) RevokeRequestInquiryBatch(ctx context.Context, userID, monetaryAccountID, id int) error {
+	body := struct {
+		Status RequestStatus `json:"status"`
+	}{RequestStatusRevoked}
+	_, err := c.doID(ctx, http.MethodPut, requestInquiryBatchEndpoint(userID, monetaryAccountID)+"/"+strconv.Itoa(id), body)
+	return err
+}
+
+// GetRequestInquiryBatch gets a RequestInquiryBatch resource at the bunq API.
+func (c *Client) GetRequestInquiryBatch(ctx context.Context, userID, monetaryAccountID, id int) (*RequestInquiryBatch, error) {
+	var rirb requestInquiryBatchResponse
+	endpoint := requestInquiryBatchEndpoint(userID, monetaryAccountID) + "/" + strconv.Itoa(id)
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &rirb); err != nil {
+		return nil, err
+	}
+
+	batches := rirb.requestInquiryBatches()
+	if len(batches) == 0 {
+		return nil, ErrRequestInquiryBatchNotFound
+	}
+
+	return batches[0], nil
+}
+
+// ListRequestInquiryBatches gets a page of RequestInquiryBatch resources of a
+// monetary account at the bunq API.
+func (c *Client) ListRequestInquiryBatches(ctx context.Context, userID, monetaryAccountID int, opts *ListOptions) ([]*RequestInquiryBatch, error) {
+	batches, _, err := c.listRequestInquiryBatches(ctx, opts.endpoint(requestInquiryBatchEndpoint(userID, monetaryAccountID)))
+	return batches, err
+}
+
+// IterateRequestInquiryBatches returns an iterator over all
+// RequestInquiryBatch resources of a monetary account at the bunq API.
+func (c *Client) IterateRequestInquiryBatches(userID, monetaryAccountID int, opts *ListOptions) *Iterator[*RequestInquiryBatch] {
+	return newIterator(opts.endpoint(requestInquiryBatchEndpoint(userID, monetaryAccountID)), c.listRequestInquiryBatches)
+}
+
+func (c *Client) listRequestInquiryBatches(ctx context.Context, endpoint string) ([]*RequestInquiryBatch, Pagination, error) {
+	var rirb requestInquiryBatchResponse
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &rirb); err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return rirb.requestInquiryBatches(), rirb.Pagination, nil
+}
@@ -0,0 +1,170 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateRequestInquiry(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "POST /v1/user/42/monetary-account/7/request-inquiry"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if exp := `{"amount_inquired":{"value":"12.50","currency":"EUR"},"counterparty_alias":{"type":"EMAIL","value":"kees@example.com","name":""},"description":"Invoice 2017-042","minimum_age":18,"require_address":"BILLING","allow_bunqme":true,"redirect_url":"https://example.com/paid"}`; string(body) != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, string(body))
+		}
+		fmt.Fprintln(w, `{"Response":[{"Id":{"id":21}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.CreateRequestInquiry(context.Background(), 42, 7, &RequestInquiryParams{
+		AmountInquired:    Amount{Units: 1250, Currency: "EUR"},
+		CounterpartyAlias: Alias{Type: AliasTypeEmail, Value: "kees@example.com"},
+		Description:       "Invoice 2017-042",
+		MinimumAge:        18,
+		RequireAddress:    RequireAddressBilling,
+		AllowBunqme:       true,
+		RedirectURL:       "https://example.com/paid",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 21 {
+		t.Errorf("Expected: `%v`, got: `%v`", 21, got)
+	}
+}
+
+func TestRevokeRequestInquiry(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "PUT /v1/user/42/monetary-account/7/request-inquiry/21"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if exp := `{"status":"REVOKED"}`; string(body) != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, string(body))
+		}
+		fmt.Fprintln(w, `{"Response":[{"Id":{"id":21}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	if err := client.RevokeRequestInquiry(context.Background(), 42, 7, 21); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetRequestInquiryPayment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "GET /v1/user/42/monetary-account/7/payment?count=200"; r.Method+" "+r.URL.RequestURI() != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.RequestURI())
+		}
+		fmt.Fprintln(w, `{"Response":[{"Payment":{"id":1338,"created":"2017-03-02 10:00:00.000000","request_reference_split_the_bill":[]}},{"Payment":{"id":1337,"created":"2017-03-01 10:00:00.000000","request_reference_split_the_bill":[{"type":"RequestInquiry","id":21}]}}],"Pagination":{}}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	requestInquiry := &RequestInquiry{
+		ID:                21,
+		CreatedAt:         Time(time.Date(2017, 2, 28, 10, 0, 0, 0, time.UTC)),
+		MonetaryAccountID: 7,
+		Status:            RequestStatusAccepted,
+	}
+	got, err := client.GetRequestInquiryPayment(context.Background(), 42, requestInquiry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != 1337 {
+		t.Errorf("Expected: `%v`, got: `%v`", 1337, got.ID)
+	}
+
+	requestInquiry.Status = RequestStatusPending
+	if _, err = client.GetRequestInquiryPayment(context.Background(), 42, requestInquiry); err != ErrPaymentNotFound {
+		t.Errorf("Expected: `%v`, got: `%v`", ErrPaymentNotFound, err)
+	}
+}
+
+func TestCreateRequestInquiryBatch(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "POST /v1/user/42/monetary-account/7/request-inquiry-batch"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if exp := `{"request_inquiries":[{"amount_inquired":{"value":"5.00","currency":"EUR"},"counterparty_alias":{"type":"EMAIL","value":"kees@example.com","name":""},"description":"Lunch","allow_bunqme":false},{"amount_inquired":{"value":"7.50","currency":"EUR"},"counterparty_alias":{"type":"EMAIL","value":"henk@example.com","name":""},"description":"Lunch","allow_bunqme":false}],"total_amount_inquired":{"value":"12.50","currency":"EUR"}}`; string(body) != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, string(body))
+		}
+		fmt.Fprintln(w, `{"Response":[{"Id":{"id":4}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	params := &RequestInquiryBatchParams{
+		RequestInquiries: []RequestInquiryParams{
+			{
+				AmountInquired:    Amount{Units: 500, Currency: "EUR"},
+				CounterpartyAlias: Alias{Type: AliasTypeEmail, Value: "kees@example.com"},
+				Description:       "Lunch",
+			},
+			{
+				AmountInquired:    Amount{Units: 750, Currency: "EUR"},
+				CounterpartyAlias: Alias{Type: AliasTypeEmail, Value: "henk@example.com"},
+				Description:       "Lunch",
+			},
+		},
+	}
+	got, err := client.CreateRequestInquiryBatch(context.Background(), 42, 7, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 4 {
+		t.Errorf("Expected: `%v`, got: `%v`", 4, got)
+	}
+	if !params.TotalAmountInquired.IsZero() {
+		t.Errorf("Expected params to be unchanged, got total: `%v`", params.TotalAmountInquired)
+	}
+}
+
+func TestGetRequestInquiry(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "GET /v1/user/42/monetary-account/7/request-inquiry/21"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		fmt.Fprintln(w, `{"Response":[{"RequestInquiry":{"id":21,"created":"2017-02-28 10:00:00.000000","time_responded":null,"monetary_account_id":7,"amount_inquired":{"value":"12.50","currency":"EUR"},"amount_responded":null,"counterparty_alias":{"display_name":"Kees"},"description":"Invoice 2017-042","status":"PENDING","minimum_age":18,"require_address":"BILLING","bunqme_share_url":"https://bunq.me/t/6d8a3c"}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.GetRequestInquiry(context.Background(), 42, 7, 21)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := &RequestInquiry{
+		ID:                21,
+		CreatedAt:         Time(time.Date(2017, 2, 28, 10, 0, 0, 0, time.UTC)),
+		MonetaryAccountID: 7,
+		AmountInquired:    Amount{Units: 1250, Currency: "EUR"},
+		CounterpartyAlias: LabelMonetaryAccount{DisplayName: "Kees"},
+		Description:       "Invoice 2017-042",
+		Status:            RequestStatusPending,
+		MinimumAge:        18,
+		RequireAddress:    RequireAddressBilling,
+		BunqmeShareURL:    "https://bunq.me/t/6d8a3c",
+	}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+}
@@ -0,0 +1,152 @@
+package bunq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// A RequestResponse is a request for payment received by a monetary account,
+// such as the counterpart of a RequestInquiry of another user.
+type RequestResponse struct {
+	ID                int                  `json:"id"`
+	CreatedAt         Time                 `json:"created"`
+	UpdatedAt         Time                 `json:"updated"`
+	TimeResponded     *Time                `json:"time_responded"`
+	TimeExpiry        *Time                `json:"time_expiry"`
+	MonetaryAccountID int                  `json:"monetary_account_id"`
+	AmountInquired    Amount               `json:"amount_inquired"`
+	AmountResponded   *Amount              `json:"amount_responded"`
+	Status            RequestStatus        `json:"status"`
+	Description       string               `json:"description"`
+	Alias             LabelMonetaryAccount `json:"alias"`
+	CounterpartyAlias LabelMonetaryAccount `json:"counterparty_alias"`
+	Attachment        []PaymentAttachment  `json:"attachment"`
+	MinimumAge        int                  `json:"minimum_age"`
+	RequireAddress    string               `json:"require_address"`
+	Type              string               `json:"type"`
+	SubType           string               `json:"sub_type"`
+	RedirectURL       string               `json:"redirect_url"`
+	AddressBilling    *Address             `json:"address_billing"`
+	AddressShipping   *Address             `json:"address_shipping"`
+}
+
+// RequestResponseParams are the fields for responding to a RequestResponse.
+// Set Status to RequestStatusAccepted to pay the request, or to
+// RequestStatusRejected to reject it.
+type RequestResponseParams struct {
+	Status RequestStatus `json:"status"`
+	// AmountResponded is the amount to pay, if it differs from the amount
+	// inquired and the request allows it.
+	AmountResponded *Amount  `json:"amount_responded,omitempty"`
+	AddressBilling  *Address `json:"address_billing,omitempty"`
+	AddressShipping *Address `json:"address_shipping,omitempty"`
+}
+
+type requestResponseResponse struct {
+	Response []struct {
+		RequestResponse *RequestResponse `json:"RequestResponse,omitempty"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+func (rrr *requestResponseResponse) requestResponses() []*RequestResponse {
+	var requestResponses []*RequestResponse
+	for i := range rrr.Response {
+		if rrr.Response[i].RequestResponse != nil {
+			requestResponses = append(requestResponses, rrr.Response[i].RequestResponse)
+		}
+	}
+
+	return requestResponses
+}
+
+// ErrRequestResponseNotFound is returned when a single RequestResponse
+// resource was not found.
+var ErrRequestResponseNotFound = errors.New("request response not found")
+
+func requestResponseEndpoint(userID, monetaryAccountID int) string {
+	return fmt.Sprintf("user/%v/monetary-account/%v/request-response", userID, monetaryAccountID)
+}
+
+// requestResponseListEndpoint returns the list endpoint of RequestResponse
+// resources, filtered by status if it is set.
+func requestResponseListEndpoint(userID, monetaryAccountID int, status RequestStatus, opts *ListOptions) string {
+	endpoint := opts.endpoint(requestResponseEndpoint(userID, monetaryAccountID))
+	if status == "" {
+		return endpoint
+	}
+	sep := "?"
+	if strings.Contains(endpoint, "?") {
+		sep = "&"
+	}
+
+	return endpoint + sep + "status=" + url.QueryEscape(string(status))
+}
+
+// UpdateRequestResponse accepts or rejects a RequestResponse resource at the
+// bunq API.
+func (c *Client) UpdateRequestResponse(ctx context.Context, userID, monetaryAccountID, id int, params *RequestResponseParams) error {
+	_, err := c.doID(ctx, http.MethodPut, requestResponseEndpoint(userID, monetaryAccountID)+"/"+strconv.Itoa(id), params)
+	return err
+}
+
+// GetRequestResponse gets a RequestResponse resource at the bunq API.
+func (c *Client) GetRequestResponse(ctx context.Context, userID, monetaryAccountID, id int) (*RequestResponse, error) {
+	var rrr requestResponseResponse
+	endpoint := requestResponseEndpoint(userID, monetaryAccountID) + "/" + strconv.Itoa(id)
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &rrr); err != nil {
+		return nil, err
+	}
+
+	requestResponses := rrr.requestResponses()
+	if len(requestResponses) == 0 {
+		return nil, ErrRequestResponseNotFound
+	}
+
+	return requestResponses[0], nil
+}
+
+// ListRequestResponses gets a page of RequestResponse resources of a monetary
+// account at the bunq API. Only requests with status are listed, unless it is
+// empty.
+func (c *Client) ListRequestResponses(ctx context.Context, userID, monetaryAccountID int, status RequestStatus, opts *ListOptions) ([]*RequestResponse, error) {
+	requestResponses, _, err := c.listRequestResponses(status)(ctx, requestResponseListEndpoint(userID, monetaryAccountID, status, opts))
+	return requestResponses, err
+}
+
+// IterateRequestResponses returns an iterator over all RequestResponse
+// resources of a monetary account at the bunq API. Only requests with status
+// are listed, unless it is empty.
+func (c *Client) IterateRequestResponses(userID, monetaryAccountID int, status RequestStatus, opts *ListOptions) *Iterator[*RequestResponse] {
+	return newIterator(requestResponseListEndpoint(userID, monetaryAccountID, status, opts), c.listRequestResponses(status))
+}
+
+// listRequestResponses returns a pageFunc for RequestResponse resources. The
+// status filter is applied to every page as well, because pagination URLs of
+// the API do not retain it.
+func (c *Client) listRequestResponses(status RequestStatus) pageFunc[*RequestResponse] {
+	return func(ctx context.Context, endpoint string) ([]*RequestResponse, Pagination, error) {
+		var rrr requestResponseResponse
+		if err := c.do(ctx, http.MethodGet, endpoint, nil, &rrr); err != nil {
+			return nil, Pagination{}, err
+		}
+
+		requestResponses := rrr.requestResponses()
+		if status == "" {
+			return requestResponses, rrr.Pagination, nil
+		}
+		var filtered []*RequestResponse
+		for _, rr := range requestResponses {
+			if rr.Status == status {
+				filtered = append(filtered, rr)
+			}
+		}
+
+		return filtered, rrr.Pagination, nil
+	}
+}
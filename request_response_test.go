@@ -0,0 +1,70 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestUpdateRequestResponse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "PUT /v1/user/42/monetary-account/7/request-response/31"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if exp := `{"status":"ACCEPTED","amount_responded":{"value":"10.00","currency":"EUR"},"address_shipping":{"street":"Naritaweg","house_number":"131","po_box":"","postal_code":"1043 BS","city":"Amsterdam","country":"NL"}}`; string(body) != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, string(body))
+		}
+		fmt.Fprintln(w, `{"Response":[{"Id":{"id":31}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	err := client.UpdateRequestResponse(context.Background(), 42, 7, 31, &RequestResponseParams{
+		Status:          RequestStatusAccepted,
+		AmountResponded: &Amount{Units: 1000, Currency: "EUR"},
+		AddressShipping: &Address{Street: "Naritaweg", HouseNumber: "131", PostalCode: "1043 BS", City: "Amsterdam", Country: "NL"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIterateRequestResponses(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.RequestURI() {
+		case "/v1/user/42/monetary-account/7/request-response?count=2&status=PENDING":
+			fmt.Fprintln(w, `{"Response":[{"RequestResponse":{"id":33,"status":"PENDING"}},{"RequestResponse":{"id":32,"status":"ACCEPTED"}}],"Pagination":{"older_url":"/v1/user/42/monetary-account/7/request-response?count=2&older_id=32"}}`)
+		case "/v1/user/42/monetary-account/7/request-response?count=2&older_id=32":
+			fmt.Fprintln(w, `{"Response":[{"RequestResponse":{"id":31,"status":"PENDING"}}],"Pagination":{}}`)
+		default:
+			t.Errorf("Unexpected request: `%v`", r.URL.RequestURI())
+		}
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	var got []*RequestResponse
+	it := client.IterateRequestResponses(42, 7, RequestStatusPending, &ListOptions{Count: 2})
+	for it.Next(context.Background()) {
+		got = append(got, it.Value())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	exp := []*RequestResponse{
+		{ID: 33, Status: RequestStatusPending},
+		{ID: 31, Status: RequestStatusPending},
+	}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+}
@@ -0,0 +1,90 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMiddleware(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Test-Middleware"); got != "outer,inner" {
+			t.Errorf("Expected: `%v`, got: `%v`", "outer,inner", got)
+		}
+		fmt.Fprintln(w, `{"Response":[{"Id":{"id":72}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	var calls []string
+	middleware := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name)
+				value := name
+				if v := req.Header.Get("X-Test-Middleware"); v != "" {
+					value = v + "," + name
+				}
+				req.Header.Set("X-Test-Middleware", value)
+				return next.Do(req)
+			})
+		}
+	}
+	client.Middleware = []Middleware{middleware("outer"), middleware("inner")}
+
+	if _, err := client.GetInstallationID(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	exp := []string{"outer", "inner"}
+	if eq := reflect.DeepEqual(exp, calls); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, calls)
+	}
+}
+
+func TestUnsignedErrorResponse(t *testing.T) {
+	var client *Client
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch calls {
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprintln(w, `<html>Too Many Requests</html>`)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintln(w, `<html>Service Unavailable</html>`)
+		default:
+			body := `{"Response":[]}`
+			writeSignedResponse(t, w, client, body, body)
+		}
+	}))
+	defer ts.Close()
+
+	client = newTestClient(t, ts.URL)
+	client.ServerPublicKey = &client.PrivateKey.PublicKey
+	client.InsecureSkipVerify = false
+
+	_, err := client.ListUsers(context.Background(), nil)
+	if !IsRateLimited(err) {
+		t.Errorf("Expected rate limited error, got: `%v`", err)
+	}
+
+	calls = 0
+	client.Retry = &RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+	}
+	if _, err = client.ListUsers(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Errorf("Expected: `%v` calls, got: `%v`", 3, calls)
+	}
+}
@@ -0,0 +1,176 @@
+package bunq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// The units in which the recurrence of a Schedule is expressed.
+const (
+	RecurrenceUnitOnce    = "ONCE"
+	RecurrenceUnitHourly  = "HOURLY"
+	RecurrenceUnitDaily   = "DAILY"
+	RecurrenceUnitWeekly  = "WEEKLY"
+	RecurrenceUnitMonthly = "MONTHLY"
+	RecurrenceUnitYearly  = "YEARLY"
+)
+
+// A Schedule describes when a scheduled object, such as a SchedulePayment, is
+// executed: once at TimeStart, or every RecurrenceSize units of
+// RecurrenceUnit from TimeStart until TimeEnd, if set.
+type Schedule struct {
+	TimeStart      Time   `json:"time_start"`
+	TimeEnd        *Time  `json:"time_end,omitempty"`
+	RecurrenceUnit string `json:"recurrence_unit"`
+	RecurrenceSize int    `json:"recurrence_size"`
+}
+
+// Validate checks that the fields of the schedule are a valid combination.
+func (s *Schedule) Validate() error {
+	if time.Time(s.TimeStart).IsZero() {
+		return errors.New("bunq: schedule has no start time")
+	}
+	if s.TimeEnd != nil && !time.Time(*s.TimeEnd).After(time.Time(s.TimeStart)) {
+		return errors.New("bunq: schedule ends before it starts")
+	}
+
+	switch s.RecurrenceUnit {
+	case RecurrenceUnitOnce:
+		if s.TimeEnd != nil {
+			return errors.New("bunq: schedule that recurs once cannot have an end time")
+		}
+		if s.RecurrenceSize > 1 {
+			return fmt.Errorf("bunq: schedule that recurs once cannot have recurrence size %v", s.RecurrenceSize)
+		}
+	case RecurrenceUnitHourly, RecurrenceUnitDaily, RecurrenceUnitWeekly, RecurrenceUnitMonthly, RecurrenceUnitYearly:
+		if s.RecurrenceSize < 1 {
+			return fmt.Errorf("bunq: schedule recurrence size must be at least 1, got %v", s.RecurrenceSize)
+		}
+	default:
+		return fmt.Errorf("bunq: invalid schedule recurrence unit %q", s.RecurrenceUnit)
+	}
+
+	return nil
+}
+
+// Next returns at most n execution times of the schedule that are not before
+// from. It returns nil if the schedule is not valid.
+func (s *Schedule) Next(from time.Time, n int) []time.Time {
+	if s.Validate() != nil {
+		return nil
+	}
+
+	start := time.Time(s.TimeStart)
+	var times []time.Time
+	prev := start
+	for i := 0; len(times) < n; i++ {
+		t := s.occurrence(start, i)
+		if s.TimeEnd != nil && t.After(time.Time(*s.TimeEnd)) {
+			break
+		}
+		if i > 0 && !t.After(prev) {
+			break
+		}
+		prev = t
+		if !t.Before(from) {
+			times = append(times, t)
+		}
+		if s.RecurrenceUnit == RecurrenceUnitOnce {
+			break
+		}
+	}
+
+	return times
+}
+
+// occurrence returns the i-th execution time of the schedule. Months and years
+// are added to start, so that executions do not drift at the end of a month.
+func (s *Schedule) occurrence(start time.Time, i int) time.Time {
+	size := i * s.RecurrenceSize
+	switch s.RecurrenceUnit {
+	case RecurrenceUnitHourly:
+		return start.Add(time.Duration(size) * time.Hour)
+	case RecurrenceUnitDaily:
+		return start.AddDate(0, 0, size)
+	case RecurrenceUnitWeekly:
+		return start.AddDate(0, 0, 7*size)
+	case RecurrenceUnitMonthly:
+		return start.AddDate(0, size, 0)
+	case RecurrenceUnitYearly:
+		return start.AddDate(size, 0, 0)
+	}
+
+	return start
+}
+
+// ScheduleInstance is a single execution of a Schedule.
+type ScheduleInstance struct {
+	ID              int                   `json:"id"`
+	CreatedAt       Time                  `json:"created"`
+	UpdatedAt       Time                  `json:"updated"`
+	State           string                `json:"state"`
+	TimeStart       *Time                 `json:"time_start"`
+	TimeEnd         *Time                 `json:"time_end"`
+	ErrorMessage    []Error               `json:"error_message"`
+	ScheduledObject *ScheduledObject      `json:"scheduled_object"`
+	ResultObject    *ScheduleResultObject `json:"result_object"`
+}
+
+// ScheduledObject holds the object that is executed by a ScheduleInstance.
+type ScheduledObject struct {
+	Payment      *SchedulePaymentEntry   `json:"Payment,omitempty"`
+	PaymentBatch *SchedulePaymentEntries `json:"PaymentBatch,omitempty"`
+}
+
+// ScheduleResultObject holds the object that was created by a ScheduleInstance.
+type ScheduleResultObject struct {
+	Payment      *Payment      `json:"Payment,omitempty"`
+	PaymentBatch *PaymentBatch `json:"PaymentBatch,omitempty"`
+}
+
+type scheduleInstanceResponse struct {
+	Response []struct {
+		ScheduleInstance *ScheduleInstance `json:"ScheduleInstance,omitempty"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+func (sir *scheduleInstanceResponse) scheduleInstances() []*ScheduleInstance {
+	var instances []*ScheduleInstance
+	for i := range sir.Response {
+		if sir.Response[i].ScheduleInstance != nil {
+			instances = append(instances, sir.Response[i].ScheduleInstance)
+		}
+	}
+
+	return instances
+}
+
+func scheduleInstanceEndpoint(userID, monetaryAccountID, scheduleID int) string {
+	return fmt.Sprintf("user/%v/monetary-account/%v/schedule/%v/schedule-instance", userID, monetaryAccountID, scheduleID)
+}
+
+// ListScheduleInstances gets a page of ScheduleInstance resources of a
+// schedule at the bunq API.
+func (c *Client) ListScheduleInstances(ctx context.Context, userID, monetaryAccountID, scheduleID int, opts *ListOptions) ([]*ScheduleInstance, error) {
+	instances, _, err := c.listScheduleInstances(ctx, opts.endpoint(scheduleInstanceEndpoint(userID, monetaryAccountID, scheduleID)))
+	return instances, err
+}
+
+// IterateScheduleInstances returns an iterator over all ScheduleInstance
+// resources of a schedule at the bunq API.
+func (c *Client) IterateScheduleInstances(userID, monetaryAccountID, scheduleID int, opts *ListOptions) *Iterator[*ScheduleInstance] {
+	return newIterator(opts.endpoint(scheduleInstanceEndpoint(userID, monetaryAccountID, scheduleID)), c.listScheduleInstances)
+}
+
+func (c *Client) listScheduleInstances(ctx context.Context, endpoint string) ([]*ScheduleInstance, Pagination, error) {
+	var sir scheduleInstanceResponse
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &sir); err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return sir.scheduleInstances(), sir.Pagination, nil
+}
@@ -0,0 +1,240 @@
+package bunq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// A SchedulePaymentEntry is a payment that is executed by a schedule.
+type SchedulePaymentEntry struct {
+	Amount            Amount               `json:"amount"`
+	Alias             LabelMonetaryAccount `json:"alias"`
+	CounterpartyAlias LabelMonetaryAccount `json:"counterparty_alias"`
+	Description       string               `json:"description"`
+	MerchantReference string               `json:"merchant_reference"`
+	Attachment        []PaymentAttachment  `json:"attachment"`
+}
+
+// SchedulePaymentEntries are the payments of a SchedulePaymentBatch, as nested
+// in a ScheduledObject.
+type SchedulePaymentEntries struct {
+	Payments []SchedulePaymentEntry `json:"payments"`
+}
+
+// A SchedulePayment is a payment from a monetary account that is executed
+// according to a Schedule, such as a standing order.
+type SchedulePayment struct {
+	ID       int                  `json:"id"`
+	Payment  SchedulePaymentEntry `json:"payment"`
+	Schedule Schedule             `json:"schedule"`
+}
+
+// SchedulePaymentParams are the fields for creating or updating a
+// SchedulePayment.
+type SchedulePaymentParams struct {
+	Payment  PaymentParams `json:"payment"`
+	Schedule Schedule      `json:"schedule"`
+}
+
+// A SchedulePaymentBatch is a batch of payments from a monetary account that
+// is executed according to a Schedule.
+type SchedulePaymentBatch struct {
+	ID       int                    `json:"id"`
+	Payments []SchedulePaymentEntry `json:"payments"`
+	Schedule Schedule               `json:"schedule"`
+}
+
+// SchedulePaymentBatchParams are the fields for creating or updating a
+// SchedulePaymentBatch.
+type SchedulePaymentBatchParams struct {
+	Payments []PaymentParams `json:"payments"`
+	Schedule Schedule        `json:"schedule"`
+}
+
+type schedulePaymentResponse struct {
+	Response []struct {
+		SchedulePayment *SchedulePayment `json:"SchedulePayment,omitempty"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+func (spr *schedulePaymentResponse) schedulePayments() []*SchedulePayment {
+	var schedulePayments []*SchedulePayment
+	for i := range spr.Response {
+		if spr.Response[i].SchedulePayment != nil {
+			schedulePayments = append(schedulePayments, spr.Response[i].SchedulePayment)
+		}
+	}
+
+	return schedulePayments
+}
+
+type schedulePaymentBatchResponse struct {
+	Response []struct {
+		SchedulePaymentBatch *SchedulePaymentBatch `json:"SchedulePaymentBatch,omitempty"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+func (spbr *schedulePaymentBatchResponse) schedulePaymentBatches() []*SchedulePaymentBatch {
+	var batches []*SchedulePaymentBatch
+	for i := range spbr.Response {
+		if spbr.Response[i].SchedulePaymentBatch != nil {
+			batches = append(batches, spbr.Response[i].SchedulePaymentBatch)
+		}
+	}
+
+	return batches
+}
+
+// ErrSchedulePaymentNotFound is returned when a single SchedulePayment
+// resource was not found.
+var ErrSchedulePaymentNotFound = errors.New("schedule payment not found")
+
+// ErrSchedulePaymentBatchNotFound is returned when a single
+// SchedulePaymentBatch resource was not found.
+var ErrSchedulePaymentBatchNotFound = errors.New("schedule payment batch not found")
+
+func schedulePaymentEndpoint(userID, monetaryAccountID int) string {
+	return fmt.Sprintf("user/%v/monetary-account/%v/schedule-payment", userID, monetaryAccountID)
+}
+
+func schedulePaymentBatchEndpoint(userID, monetaryAccountID int) string {
+	return fmt.Sprintf("user/%v/monetary-account/%v/schedule-payment-batch", userID, monetaryAccountID)
+}
+
+// CreateSchedulePayment validates the schedule of params and creates a
+// SchedulePayment resource from a monetary account at the bunq API. It returns
+// the ID of the created resource.
+func (c *Client) CreateSchedulePayment(ctx context.Context, userID, monetaryAccountID int, params *SchedulePaymentParams) (int, error) {
+	if err := params.Schedule.Validate(); err != nil {
+		return 0, err
+	}
+
+	return c.doID(ctx, http.MethodPost, schedulePaymentEndpoint(userID, monetaryAccountID), params)
+}
+
+// UpdateSchedulePayment validates the schedule of params and updates a
+// SchedulePayment resource at the bunq API.
+func (c *Client) UpdateSchedulePayment(ctx context.Context, userID, monetaryAccountID, id int, params *SchedulePaymentParams) error {
+	if err := params.Schedule.Validate(); err != nil {
+		return err
+	}
+
+	_, err := c.doID(ctx, http.MethodPut, schedulePaymentEndpoint(userID, monetaryAccountID)+"/"+strconv.Itoa(id), params)
+	return err
+}
+
+// DeleteSchedulePayment deletes a SchedulePayment resource at the bunq API,
+// which cancels its future executions.
+func (c *Client) DeleteSchedulePayment(ctx context.Context, userID, monetaryAccountID, id int) error {
+	return c.do(ctx, http.MethodDelete, schedulePaymentEndpoint(userID, monetaryAccountID)+"/"+strconv.Itoa(id), nil, nil)
+}
+
+// GetSchedulePayment gets a SchedulePayment resource at the bunq API.
+func (c *Client) GetSchedulePayment(ctx context.Context, userID, monetaryAccountID, id int) (*SchedulePayment, error) {
+	var spr schedulePaymentResponse
+	endpoint := schedulePaymentEndpoint(userID, monetaryAccountID) + "/" + strconv.Itoa(id)
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &spr); err != nil {
+		return nil, err
+	}
+
+	schedulePayments := spr.schedulePayments()
+	if len(schedulePayments) == 0 {
+		return nil, ErrSchedulePaymentNotFound
+	}
+
+	return schedulePayments[0], nil
+}
+
+// ListSchedulePayments gets a page of SchedulePayment resources of a monetary
+// account at the bunq API.
+func (c *Client) ListSchedulePayments(ctx context.Context, userID, monetaryAccountID int, opts *ListOptions) ([]*SchedulePayment, error) {
+	schedulePayments, _, err := c.listSchedulePayments(ctx, opts.endpoint(schedulePaymentEndpoint(userID, monetaryAccountID)))
+	return schedulePayments, err
+}
+
+// IterateSchedulePayments returns an iterator over all SchedulePayment
+// resources of a monetary account at the bunq API.
+func (c *Client) IterateSchedulePayments(userID, monetaryAccountID int, opts *ListOptions) *Iterator[*SchedulePayment] {
+	return newIterator(opts.endpoint(schedulePaymentEndpoint(userID, monetaryAccountID)), c.listSchedulePayments)
+}
+
+func (c *Client) listSchedulePayments(ctx context.Context, endpoint string) ([]*SchedulePayment, Pagination, error) {
+	var spr schedulePaymentResponse
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &spr); err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return spr.schedulePayments(), spr.Pagination, nil
+}
+
+// CreateSchedulePaymentBatch validates the schedule of params and creates a
+// SchedulePaymentBatch resource from a monetary account at the bunq API. It
+// returns the ID of the created resource.
+func (c *Client) CreateSchedulePaymentBatch(ctx context.Context, userID, monetaryAccountID int, params *SchedulePaymentBatchParams) (int, error) {
+	if err := params.Schedule.Validate(); err != nil {
+		return 0, err
+	}
+
+	return c.doID(ctx, http.MethodPost, schedulePaymentBatchEndpoint(userID, monetaryAccountID), params)
+}
+
+// UpdateSchedulePaymentBatch validates the schedule of params and updates a
+// SchedulePaymentBatch resource at the bunq API.
+func (c *Client) UpdateSchedulePaymentBatch(ctx context.Context, userID, monetaryAccountID, id int, params *SchedulePaymentBatchParams) error {
+	if err := params.Schedule.Validate(); err != nil {
+		return err
+	}
+
+	_, err := c.doID(ctx, http.MethodPut, schedulePaymentBatchEndpoint(userID, monetaryAccountID)+"/"+strconv.Itoa(id), params)
+	return err
+}
+
+// DeleteSchedulePaymentBatch deletes a SchedulePaymentBatch resource at the
+// bunq API, which cancels its future executions.
+func (c *Client) DeleteSchedulePaymentBatch(ctx context.Context, userID, monetaryAccountID, id int) error {
+	return c.do(ctx, http.MethodDelete, schedulePaymentBatchEndpoint(userID, monetaryAccountID)+"/"+strconv.Itoa(id), nil, nil)
+}
+
+// GetSchedulePaymentBatch gets a SchedulePaymentBatch resource at the bunq
+// API.
+func (c *Client) GetSchedulePaymentBatch(ctx context.Context, userID, monetaryAccountID, id int) (*SchedulePaymentBatch, error) {
+	var spbr schedulePaymentBatchResponse
+	endpoint := schedulePaymentBatchEndpoint(userID, monetaryAccountID) + "/" + strconv.Itoa(id)
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &spbr); err != nil {
+		return nil, err
+	}
+
+	batches := spbr.schedulePaymentBatches()
+	if len(batches) == 0 {
+		return nil, ErrSchedulePaymentBatchNotFound
+	}
+
+	return batches[0], nil
+}
+
+// ListSchedulePaymentBatches gets a page of SchedulePaymentBatch resources of
+// a monetary account at the bunq API.
+func (c *Client) ListSchedulePaymentBatches(ctx context.Context, userID, monetaryAccountID int, opts *ListOptions) ([]*SchedulePaymentBatch, error) {
+	batches, _, err := c.listSchedulePaymentBatches(ctx, opts.endpoint(schedulePaymentBatchEndpoint(userID, monetaryAccountID)))
+	return batches, err
+}
+
+// IterateSchedulePaymentBatches returns an iterator over all
+// SchedulePaymentBatch resources of a monetary account at the bunq API.
+func (c *Client) IterateSchedulePaymentBatches(userID, monetaryAccountID int, opts *ListOptions) *Iterator[*SchedulePaymentBatch] {
+	return newIterator(opts.endpoint(schedulePaymentBatchEndpoint(userID, monetaryAccountID)), c.listSchedulePaymentBatches)
+}
+
+func (c *Client) listSchedulePaymentBatches(ctx context.Context, endpoint string) ([]*SchedulePaymentBatch, Pagination, error) {
+	var spbr schedulePaymentBatchResponse
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &spbr); err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return spbr.schedulePaymentBatches(), spbr.Pagination, nil
+}
@@ -0,0 +1,100 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateSchedulePayment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "POST /v1/user/42/monetary-account/7/schedule-payment"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if exp := `{"payment":{"amount":{"value":"750.00","currency":"EUR"},"counterparty_alias":{"type":"IBAN","value":"NL02BUNQ0123456789","name":"Kees"},"description":"Rent"},"schedule":{"time_start":"2017-01-15 09:00:00.000000","recurrence_unit":"MONTHLY","recurrence_size":1}}`; string(body) != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, string(body))
+		}
+		fmt.Fprintln(w, `{"Response":[{"Id":{"id":9}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	params := &SchedulePaymentParams{
+		Payment: PaymentParams{
+			Amount:            Amount{Units: 75000, Currency: "EUR"},
+			CounterpartyAlias: Alias{Type: AliasTypeIBAN, Value: "NL02BUNQ0123456789", Name: "Kees"},
+			Description:       "Rent",
+		},
+		Schedule: Schedule{
+			TimeStart:      Time(time.Date(2017, 1, 15, 9, 0, 0, 0, time.UTC)),
+			RecurrenceUnit: RecurrenceUnitMonthly,
+			RecurrenceSize: 1,
+		},
+	}
+	got, err := client.CreateSchedulePayment(context.Background(), 42, 7, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 9 {
+		t.Errorf("Expected: `%v`, got: `%v`", 9, got)
+	}
+
+	params.Schedule.RecurrenceSize = 0
+	if _, err = client.CreateSchedulePayment(context.Background(), 42, 7, params); err == nil {
+		t.Error("Expected error for invalid schedule.")
+	}
+}
+
+func TestDeleteSchedulePayment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "DELETE /v1/user/42/monetary-account/7/schedule-payment/9"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		fmt.Fprintln(w, `{"Response":[]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	if err := client.DeleteSchedulePayment(context.Background(), 42, 7, 9); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetSchedulePaymentBatch(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "GET /v1/user/42/monetary-account/7/schedule-payment-batch/10"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		fmt.Fprintln(w, `{"Response":[{"SchedulePaymentBatch":{"id":10,"payments":[{"amount":{"value":"12.50","currency":"EUR"},"description":"Salary"}],"schedule":{"time_start":"2017-01-25 09:00:00.000000","time_end":null,"recurrence_unit":"MONTHLY","recurrence_size":1}}}]}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.GetSchedulePaymentBatch(context.Background(), 42, 7, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := &SchedulePaymentBatch{
+		ID:       10,
+		Payments: []SchedulePaymentEntry{{Amount: Amount{Units: 1250, Currency: "EUR"}, Description: "Salary"}},
+		Schedule: Schedule{
+			TimeStart:      Time(time.Date(2017, 1, 25, 9, 0, 0, 0, time.UTC)),
+			RecurrenceUnit: RecurrenceUnitMonthly,
+			RecurrenceSize: 1,
+		},
+	}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+}
@@ -0,0 +1,127 @@
+package bunq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestScheduleValidate(t *testing.T) {
+	start := Time(time.Date(2017, 1, 31, 9, 0, 0, 0, time.UTC))
+	end := Time(time.Date(2017, 12, 31, 9, 0, 0, 0, time.UTC))
+	before := Time(time.Date(2016, 12, 31, 9, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		valid    bool
+	}{
+		{"once", Schedule{TimeStart: start, RecurrenceUnit: RecurrenceUnitOnce, RecurrenceSize: 1}, true},
+		{"monthly until end", Schedule{TimeStart: start, TimeEnd: &end, RecurrenceUnit: RecurrenceUnitMonthly, RecurrenceSize: 1}, true},
+		{"no start", Schedule{RecurrenceUnit: RecurrenceUnitOnce}, false},
+		{"end before start", Schedule{TimeStart: start, TimeEnd: &before, RecurrenceUnit: RecurrenceUnitDaily, RecurrenceSize: 1}, false},
+		{"once with end", Schedule{TimeStart: start, TimeEnd: &end, RecurrenceUnit: RecurrenceUnitOnce}, false},
+		{"once with size", Schedule{TimeStart: start, RecurrenceUnit: RecurrenceUnitOnce, RecurrenceSize: 2}, false},
+		{"weekly without size", Schedule{TimeStart: start, RecurrenceUnit: RecurrenceUnitWeekly}, false},
+		{"unknown unit", Schedule{TimeStart: start, RecurrenceUnit: "FORTNIGHTLY", RecurrenceSize: 1}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.schedule.Validate(); (err == nil) != tt.valid {
+				t.Errorf("Unexpected validation result: %v", err)
+			}
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	end := Time(time.Date(2017, 5, 1, 0, 0, 0, 0, time.UTC))
+	schedule := Schedule{
+		TimeStart:      Time(time.Date(2017, 1, 15, 9, 0, 0, 0, time.UTC)),
+		TimeEnd:        &end,
+		RecurrenceUnit: RecurrenceUnitMonthly,
+		RecurrenceSize: 1,
+	}
+
+	got := schedule.Next(time.Date(2017, 2, 1, 0, 0, 0, 0, time.UTC), 5)
+	exp := []time.Time{
+		time.Date(2017, 2, 15, 9, 0, 0, 0, time.UTC),
+		time.Date(2017, 3, 15, 9, 0, 0, 0, time.UTC),
+		time.Date(2017, 4, 15, 9, 0, 0, 0, time.UTC),
+	}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+
+	// Invalid schedules, e.g. decoded from an API response, without an end
+	// time must not make Next loop forever.
+	from := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, invalid := range []Schedule{
+		{TimeStart: schedule.TimeStart, RecurrenceUnit: RecurrenceUnitMonthly, RecurrenceSize: 0},
+		{TimeStart: schedule.TimeStart, RecurrenceUnit: "FORTNIGHTLY", RecurrenceSize: 1},
+	} {
+		if got := invalid.Next(from, 5); got != nil {
+			t.Errorf("Expected: `%#v`, got: `%#v`", []time.Time(nil), got)
+		}
+	}
+}
+
+func TestScheduleMarshalJSON(t *testing.T) {
+	end := Time(time.Date(2017, 12, 31, 9, 0, 0, 0, time.UTC))
+	schedule := Schedule{
+		TimeStart:      Time(time.Date(2017, 1, 31, 9, 0, 0, 0, time.UTC)),
+		TimeEnd:        &end,
+		RecurrenceUnit: RecurrenceUnitMonthly,
+		RecurrenceSize: 1,
+	}
+
+	// Marshal a value instead of a pointer, whose fields are not addressable.
+	got, err := json.Marshal(schedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := `{"time_start":"2017-01-31 09:00:00.000000","time_end":"2017-12-31 09:00:00.000000","recurrence_unit":"MONTHLY","recurrence_size":1}`
+	if string(got) != exp {
+		t.Errorf("Expected: `%v`, got: `%v`", exp, string(got))
+	}
+}
+
+func TestListScheduleInstances(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if exp := "GET /v1/user/42/monetary-account/7/schedule/9/schedule-instance"; r.Method+" "+r.URL.Path != exp {
+			t.Errorf("Expected: `%v`, got: `%v`", exp, r.Method+" "+r.URL.Path)
+		}
+		fmt.Fprintln(w, `{"Response":[{"ScheduleInstance":{"id":11,"state":"FINISHED_SUCCESSFULLY","time_start":"2017-02-15 09:00:00.000000","time_end":null,"error_message":[],"scheduled_object":{"Payment":{"amount":{"value":"12.50","currency":"EUR"},"description":"Rent"}},"result_object":{"Payment":{"id":1337}}}}],"Pagination":{}}`)
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	got, err := client.ListScheduleInstances(context.Background(), 42, 7, 9, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeStart := Time(time.Date(2017, 2, 15, 9, 0, 0, 0, time.UTC))
+	exp := []*ScheduleInstance{{
+		ID:           11,
+		State:        "FINISHED_SUCCESSFULLY",
+		TimeStart:    &timeStart,
+		ErrorMessage: []Error{},
+		ScheduledObject: &ScheduledObject{
+			Payment: &SchedulePaymentEntry{Amount: Amount{Units: 1250, Currency: "EUR"}, Description: "Rent"},
+		},
+		ResultObject: &ScheduleResultObject{Payment: &Payment{ID: 1337}},
+	}}
+
+	if eq := reflect.DeepEqual(exp, got); !eq {
+		t.Errorf("Expected: `%#v`, got: `%#v`", exp, got)
+	}
+}
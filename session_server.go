@@ -1,11 +1,11 @@
 package bunq
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 type sessionServerResponse struct {
@@ -17,7 +17,7 @@ type sessionServerResponse struct {
 			ID    int    `json:"id"`
 			Token string `json:"token"`
 		} `json:"Token,omitempty"`
-		UserCompany *UserCompany `json:"UserCompany,omitempty"`
+		userEnvelope
 	} `json:"Response"`
 }
 
@@ -29,59 +29,105 @@ type SessionToken struct {
 
 // A Session represents a SessionServer at the bunq API.
 type Session struct {
-	ID          int
-	Token       SessionToken
-	UserCompany UserCompany
+	ID    int
+	Token SessionToken
+	// User is the user that the session belongs to.
+	User User
 }
 
-// CreateSession creates a new session for a DeviceServer at the bunq API.
-func (c *Client) CreateSession() (*Session, error) {
+// CreateSession creates a new session for a DeviceServer at the bunq API. The
+// request is authenticated with the installation token of the client, if set.
+func (c *Client) CreateSession(ctx context.Context) (*Session, error) {
 	body := struct {
 		Secret string `json:"secret"`
 	}{c.APIKey}
 
-	bodyJSON, err := json.Marshal(body)
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not encode request body into JSON: %v", err)
+	var ssr sessionServerResponse
+	if err := c.doInstallation(ctx, http.MethodPost, "session-server", body, &ssr); err != nil {
+		return nil, err
 	}
 
-	httpMethod := http.MethodPost
-	endpoint := apiVersion + "/session-server"
-	req, err := http.NewRequest(httpMethod, fmt.Sprintf("%v/%v", c.BaseURL, endpoint), bytes.NewReader(bodyJSON))
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not create new request: %v", err)
-	}
-	setCommonHeaders(req)
-	req.Header.Set("X-Bunq-Client-Authentication", c.Token)
-	if err = c.addSignature(req, fmt.Sprintf("%v /%v", httpMethod, endpoint), string(bodyJSON)); err != nil {
-		return nil, fmt.Errorf("bunq: could not add signature: %v", err)
+	if len(ssr.Response) == 0 {
+		return nil, errors.New("bunq: api response did not contain results")
 	}
 
-	resp, err := c.HTTPClient.Do(req)
+	session, err := ssr.session()
 	if err != nil {
-		return nil, fmt.Errorf("bunq: could not send HTTP request: %v", err)
+		return nil, fmt.Errorf("bunq: could not parse API response: %v", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("bunq: request was unsuccessful: %v", decodeError(resp.Body))
+	return session, nil
+}
+
+// sessionRenewalMargin is the time before its expiry at which a session gets
+// renewed.
+const sessionRenewalMargin = 30 * time.Second
+
+// startSession creates a new session and stores its token and expiry time on
+// the client.
+func (c *Client) startSession(ctx context.Context) (*Session, error) {
+	session, err := c.CreateSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if session.Token.Token == "" {
+		return nil, errors.New("bunq: api response did not contain a session token")
 	}
 
-	var ssr sessionServerResponse
-	if err = json.NewDecoder(resp.Body).Decode(&ssr); err != nil {
-		return nil, fmt.Errorf("bunq: could not decode HTTP response: %v", err)
+	var expiry time.Time
+	if timeout := sessionTimeout(session.User); timeout > 0 {
+		expiry = time.Now().Add(time.Duration(timeout) * time.Second)
 	}
 
-	if len(ssr.Response) == 0 {
-		return nil, errors.New("bunq: api response did not contain results")
+	c.mu.Lock()
+	c.Token = session.Token.Token
+	c.SessionExpiry = expiry
+	c.mu.Unlock()
+
+	return session, nil
+}
+
+// renewSession starts a new session, unless the session with token stale was
+// already renewed by another goroutine.
+func (c *Client) renewSession(ctx context.Context, stale string) error {
+	c.renewMu.Lock()
+	defer c.renewMu.Unlock()
+
+	if token, _ := c.session(); token != stale {
+		return nil
+	}
+	if _, err := c.startSession(ctx); err != nil {
+		return fmt.Errorf("bunq: could not renew session: %w", err)
 	}
 
-	session, err := ssr.session()
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not parse API response: %v", err)
+	return nil
+}
+
+// sessionTimeout returns the session timeout in seconds of user, or zero if it
+// is unknown.
+func sessionTimeout(user User) int {
+	switch u := user.(type) {
+	case UserPerson:
+		return u.SessionTimeout
+	case UserCompany:
+		return u.SessionTimeout
+	case UserLight:
+		return u.SessionTimeout
+	case UserPaymentServiceProvider:
+		return u.SessionTimeout
+	case UserAPIKey:
+		return sessionTimeout(u.GrantedByUser)
 	}
 
-	return session, nil
+	return 0
+}
+
+// session returns the current session token and its expiry time.
+func (c *Client) session() (string, time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.Token, c.SessionExpiry
 }
 
 func (ssr *sessionServerResponse) session() (*Session, error) {
@@ -96,8 +142,8 @@ func (ssr *sessionServerResponse) session() (*Session, error) {
 			session.Token.Token = ssr.Response[i].Token.Token
 			continue
 		}
-		if ssr.Response[i].UserCompany != nil {
-			session.UserCompany = *ssr.Response[i].UserCompany
+		if user := ssr.Response[i].user(); user != nil {
+			session.User = user
 		}
 	}
 
@@ -30,7 +30,9 @@ func (ariTime *Time) UnmarshalJSON(input []byte) error {
 }
 
 // MarshalText implements the encoding.MarshalText interface for custom time type.
-func (ariTime *Time) MarshalText() ([]byte, error) {
-	t := time.Time(*ariTime)
+// It has a value receiver, so that Time values in structs that are not
+// addressable, e.g. passed by value to json.Marshal, are encoded too.
+func (ariTime Time) MarshalText() ([]byte, error) {
+	t := time.Time(ariTime)
 	return []byte(t.Format("2006-01-02 15:04:05.000000")), nil
 }
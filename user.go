@@ -1,49 +1,63 @@
 package bunq
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 )
 
-type userResponse struct {
-	Response []struct {
-		UserCompany *UserCompany `json:"UserCompany"`
-	} `json:"Response"`
+// A User is a user at the bunq API. It is one of UserPerson, UserCompany,
+// UserLight, UserAPIKey or UserPaymentServiceProvider.
+type User interface {
+	GetID() int
+	GetDisplayName() string
+	GetAliases() []Alias
+	GetStatus() string
 }
 
-// ErrUserNotFound is returned when a single User resource was not found.
-var ErrUserNotFound = errors.New("user not found")
+// userEnvelope wraps a User of any type in API responses.
+type userEnvelope struct {
+	UserPerson                 *UserPerson                 `json:"UserPerson,omitempty"`
+	UserCompany                *UserCompany                `json:"UserCompany,omitempty"`
+	UserLight                  *UserLight                  `json:"UserLight,omitempty"`
+	UserAPIKey                 *UserAPIKey                 `json:"UserApiKey,omitempty"`
+	UserPaymentServiceProvider *UserPaymentServiceProvider `json:"UserPaymentServiceProvider,omitempty"`
+}
 
-// GetUser gets a User resource at the bunq API.
-func (c *Client) GetUser(id int) (interface{}, error) {
-	httpMethod := http.MethodGet
-	endpoint := apiVersion + "/user/" + strconv.Itoa(id)
-	req, err := http.NewRequest(httpMethod, fmt.Sprintf("%v/%v", c.BaseURL, endpoint), nil)
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not create new request: %v", err)
-	}
-	setCommonHeaders(req)
-	req.Header.Set("X-Bunq-Client-Authentication", c.Token)
-	if err = c.addSignature(req, fmt.Sprintf("%v /%v", httpMethod, endpoint), ""); err != nil {
-		return nil, fmt.Errorf("bunq: could not add signature: %v", err)
+// user returns the wrapped user, or nil if the envelope contains no user of
+// a known type.
+func (e userEnvelope) user() User {
+	switch {
+	case e.UserPerson != nil:
+		return *e.UserPerson
+	case e.UserCompany != nil:
+		return *e.UserCompany
+	case e.UserLight != nil:
+		return *e.UserLight
+	case e.UserAPIKey != nil:
+		return *e.UserAPIKey
+	case e.UserPaymentServiceProvider != nil:
+		return *e.UserPaymentServiceProvider
 	}
 
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not send HTTP request: %v", err)
-	}
-	defer resp.Body.Close()
+	return nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("bunq: request was unsuccessful: %v", decodeError(resp.Body))
-	}
+type userResponse struct {
+	Response   []userEnvelope `json:"Response"`
+	Pagination Pagination     `json:"Pagination"`
+}
+
+// ErrUserNotFound is returned when a single User resource was not found.
+var ErrUserNotFound = errors.New("user not found")
 
+// GetUser gets a User resource at the bunq API.
+func (c *Client) GetUser(ctx context.Context, id int) (User, error) {
 	var userResp userResponse
-	if err = json.NewDecoder(resp.Body).Decode(&userResp); err != nil {
-		return nil, fmt.Errorf("bunq: could not decode HTTP response: %v", err)
+	if err := c.do(ctx, http.MethodGet, "user/"+strconv.Itoa(id), nil, &userResp); err != nil {
+		return nil, err
 	}
 
 	users, err := userResp.users()
@@ -57,48 +71,36 @@ func (c *Client) GetUser(id int) (interface{}, error) {
 	return users[0], nil
 }
 
-// ListUsers gets a list of User resources at the bunq API.
-func (c *Client) ListUsers() ([]interface{}, error) {
-	httpMethod := http.MethodGet
-	endpoint := apiVersion + "/user"
-	req, err := http.NewRequest(httpMethod, fmt.Sprintf("%v/%v", c.BaseURL, endpoint), nil)
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not create new request: %v", err)
-	}
-	setCommonHeaders(req)
-	req.Header.Set("X-Bunq-Client-Authentication", c.Token)
-	if err = c.addSignature(req, fmt.Sprintf("%v /%v", httpMethod, endpoint), ""); err != nil {
-		return nil, fmt.Errorf("bunq: could not add signature: %v", err)
-	}
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("bunq: could not send HTTP request: %v", err)
-	}
-	defer resp.Body.Close()
+// ListUsers gets a page of User resources at the bunq API.
+func (c *Client) ListUsers(ctx context.Context, opts *ListOptions) ([]User, error) {
+	users, _, err := c.listUsers(ctx, opts.endpoint("user"))
+	return users, err
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("bunq: request was unsuccessful: %v", decodeError(resp.Body))
-	}
+// IterateUsers returns an iterator over all User resources at the bunq API.
+func (c *Client) IterateUsers(opts *ListOptions) *Iterator[User] {
+	return newIterator(opts.endpoint("user"), c.listUsers)
+}
 
+func (c *Client) listUsers(ctx context.Context, endpoint string) ([]User, Pagination, error) {
 	var userResp userResponse
-	if err = json.NewDecoder(resp.Body).Decode(&userResp); err != nil {
-		return nil, fmt.Errorf("bunq: could not decode HTTP response: %v", err)
+	if err := c.do(ctx, http.MethodGet, endpoint, nil, &userResp); err != nil {
+		return nil, Pagination{}, err
 	}
 
 	users, err := userResp.users()
 	if err != nil {
-		return nil, fmt.Errorf("bunq: could not parse API response: %v", err)
+		return nil, Pagination{}, fmt.Errorf("bunq: could not parse API response: %v", err)
 	}
 
-	return users, nil
+	return users, userResp.Pagination, nil
 }
 
-func (userResp *userResponse) users() ([]interface{}, error) {
-	users := make([]interface{}, len(userResp.Response))
+func (userResp *userResponse) users() ([]User, error) {
+	var users []User
 	for i := range userResp.Response {
-		if userResp.Response[i].UserCompany != nil {
-			users[i] = *userResp.Response[i].UserCompany
+		if user := userResp.Response[i].user(); user != nil {
+			users = append(users, user)
 		}
 	}
 
@@ -0,0 +1,66 @@
+package bunq
+
+import "encoding/json"
+
+// UserAPIKey is a user at the bunq API that represents an API key, which was
+// granted by one user to another user to access its accounts.
+type UserAPIKey struct {
+	ID              int
+	CreatedAt       Time
+	UpdatedAt       Time
+	RequestedByUser User
+	GrantedByUser   User
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It decodes the
+// users that requested and granted the API key into their specific types.
+func (u *UserAPIKey) UnmarshalJSON(data []byte) error {
+	var v struct {
+		ID              int          `json:"id"`
+		CreatedAt       Time         `json:"created"`
+		UpdatedAt       Time         `json:"updated"`
+		RequestedByUser userEnvelope `json:"requested_by_user"`
+		GrantedByUser   userEnvelope `json:"granted_by_user"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*u = UserAPIKey{
+		ID:              v.ID,
+		CreatedAt:       v.CreatedAt,
+		UpdatedAt:       v.UpdatedAt,
+		RequestedByUser: v.RequestedByUser.user(),
+		GrantedByUser:   v.GrantedByUser.user(),
+	}
+
+	return nil
+}
+
+// GetID returns the ID of the user.
+func (u UserAPIKey) GetID() int { return u.ID }
+
+// GetDisplayName returns the display name of the user that granted the API
+// key.
+func (u UserAPIKey) GetDisplayName() string {
+	if u.GrantedByUser == nil {
+		return ""
+	}
+	return u.GrantedByUser.GetDisplayName()
+}
+
+// GetAliases returns the aliases of the user that granted the API key.
+func (u UserAPIKey) GetAliases() []Alias {
+	if u.GrantedByUser == nil {
+		return nil
+	}
+	return u.GrantedByUser.GetAliases()
+}
+
+// GetStatus returns the status of the user that granted the API key.
+func (u UserAPIKey) GetStatus() string {
+	if u.GrantedByUser == nil {
+		return ""
+	}
+	return u.GrantedByUser.GetStatus()
+}
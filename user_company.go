@@ -46,10 +46,8 @@ type DirectorAlias struct {
 	Country        string `json:"country"`
 }
 
-type Limit struct {
-	Value    string `json:"value"`
-	Currency string `json:"currency"`
-}
+// Limit is an Amount that limits spending.
+type Limit = Amount
 
 type UserCompany struct {
 	ID                                 int                  `json:"id"`
@@ -78,3 +76,15 @@ type UserCompany struct {
 	DailyLimitWithoutConfirmationLogin Limit                `json:"daily_limit_without_confirmation_login"`
 	NotificationFilters                []NotificationFilter `json:"notification_filters"`
 }
+
+// GetID returns the ID of the user.
+func (u UserCompany) GetID() int { return u.ID }
+
+// GetDisplayName returns the display name of the user.
+func (u UserCompany) GetDisplayName() string { return u.DisplayName }
+
+// GetAliases returns the aliases of the user.
+func (u UserCompany) GetAliases() []Alias { return u.Alias }
+
+// GetStatus returns the status of the user.
+func (u UserCompany) GetStatus() string { return u.Status }
@@ -0,0 +1,49 @@
+package bunq
+
+// UserLight is a user at the bunq API with a light account, which has fewer
+// features than the account of a UserPerson.
+type UserLight struct {
+	ID                                 int                  `json:"id"`
+	CreatedAt                          Time                 `json:"created"`
+	UpdatedAt                          Time                 `json:"updated"`
+	PublicUUID                         string               `json:"public_uuid"`
+	FirstName                          string               `json:"first_name"`
+	MiddleName                         string               `json:"middle_name"`
+	LastName                           string               `json:"last_name"`
+	LegalName                          string               `json:"legal_name"`
+	DisplayName                        string               `json:"display_name"`
+	PublicNickName                     string               `json:"public_nick_name"`
+	Alias                              []Alias              `json:"alias"`
+	TaxResident                        []TaxResident        `json:"tax_resident"`
+	DocumentType                       string               `json:"document_type"`
+	DocumentNumber                     string               `json:"document_number"`
+	DocumentCountryOfIssuance          string               `json:"document_country_of_issuance"`
+	AddressMain                        Address              `json:"address_main"`
+	AddressPostal                      Address              `json:"address_postal"`
+	DateOfBirth                        Time                 `json:"date_of_birth"`
+	PlaceOfBirth                       string               `json:"place_of_birth"`
+	CountryOfBirth                     string               `json:"country_of_birth"`
+	Nationality                        string               `json:"nationality"`
+	Language                           string               `json:"language"`
+	Region                             string               `json:"region"`
+	Gender                             string               `json:"gender"`
+	Avatar                             Avatar               `json:"avatar"`
+	VersionTermsOfService              string               `json:"version_terms_of_service"`
+	Status                             string               `json:"status"`
+	SubStatus                          string               `json:"sub_status"`
+	SessionTimeout                     int                  `json:"session_timeout"`
+	DailyLimitWithoutConfirmationLogin Limit                `json:"daily_limit_without_confirmation_login"`
+	NotificationFilters                []NotificationFilter `json:"notification_filters"`
+}
+
+// GetID returns the ID of the user.
+func (u UserLight) GetID() int { return u.ID }
+
+// GetDisplayName returns the display name of the user.
+func (u UserLight) GetDisplayName() string { return u.DisplayName }
+
+// GetAliases returns the aliases of the user.
+func (u UserLight) GetAliases() []Alias { return u.Alias }
+
+// GetStatus returns the status of the user.
+func (u UserLight) GetStatus() string { return u.Status }
@@ -0,0 +1,32 @@
+package bunq
+
+// UserPaymentServiceProvider is a user at the bunq API for a payment service
+// provider that accesses accounts of other users under PSD2.
+type UserPaymentServiceProvider struct {
+	ID                           int     `json:"id"`
+	CreatedAt                    Time    `json:"created"`
+	UpdatedAt                    Time    `json:"updated"`
+	CertificateDistinguishedName string  `json:"certificate_distinguished_name"`
+	Alias                        []Alias `json:"alias"`
+	Avatar                       Avatar  `json:"avatar"`
+	Status                       string  `json:"status"`
+	SubStatus                    string  `json:"sub_status"`
+	PublicUUID                   string  `json:"public_uuid"`
+	DisplayName                  string  `json:"display_name"`
+	PublicNickName               string  `json:"public_nick_name"`
+	Language                     string  `json:"language"`
+	Region                       string  `json:"region"`
+	SessionTimeout               int     `json:"session_timeout"`
+}
+
+// GetID returns the ID of the user.
+func (u UserPaymentServiceProvider) GetID() int { return u.ID }
+
+// GetDisplayName returns the display name of the user.
+func (u UserPaymentServiceProvider) GetDisplayName() string { return u.DisplayName }
+
+// GetAliases returns the aliases of the user.
+func (u UserPaymentServiceProvider) GetAliases() []Alias { return u.Alias }
+
+// GetStatus returns the status of the user.
+func (u UserPaymentServiceProvider) GetStatus() string { return u.Status }